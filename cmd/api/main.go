@@ -3,7 +3,7 @@ package main
 import (
 	"context"
 	"log"
-	"os"
+	"trainer/internal/config"
 	"trainer/internal/infrastructure/database"
 	"trainer/internal/interfaces/http"
 )
@@ -11,17 +11,19 @@ import (
 func main() {
 	ctx := context.Background()
 
-	cfg := database.DefaultConfig()
-	cfg.DSN = os.Getenv("DB_DSN")
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
 
-	db, err := database.New(ctx, cfg)
+	db, err := database.New(ctx, &cfg.Database)
 
 	if err != nil {
 		log.Fatalf("db connect: %v", err)
 	}
 	defer db.Close()
 
-	srv := http.NewServer(db, os.Getenv("CORS_ALLOWED_ORIGINS"))
+	srv := http.NewServer(db, cfg)
 	if err := srv.Run(ctx); err != nil {
 		log.Fatalf("server run: %v", err)
 	}
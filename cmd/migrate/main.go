@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"trainer/internal/config"
 	"trainer/internal/infrastructure/database"
 )
 
@@ -20,8 +21,10 @@ func main() {
 
 	cmd := os.Args[1]
 
-	cfg := database.DefaultConfig()
-	cfg.DSN = os.Getenv("DB_DSN")
+	cfg, err := config.LoadDatabase()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	ctx := context.Background()
 	db, err := database.New(ctx, cfg)
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens
+    ADD COLUMN family_id UUID,
+    ADD COLUMN revoked_at TIMESTAMP;
+
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_refresh_tokens_family_id;
+DROP INDEX idx_refresh_tokens_user_id;
+
+DELETE FROM refresh_tokens WHERE revoked_at IS NOT NULL;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN revoked_at,
+    DROP COLUMN family_id;
+-- +goose StatementEnd
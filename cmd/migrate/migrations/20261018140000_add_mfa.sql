@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN mfa_secret VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN mfa_enabled_at TIMESTAMP,
+    ADD COLUMN mfa_last_step BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN mfa_recovery_codes TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE mfa_challenges (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE mfa_challenges;
+
+ALTER TABLE users
+    DROP COLUMN mfa_recovery_codes,
+    DROP COLUMN mfa_last_step,
+    DROP COLUMN mfa_enabled_at,
+    DROP COLUMN mfa_secret;
+-- +goose StatementEnd
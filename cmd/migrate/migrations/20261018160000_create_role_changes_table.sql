@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- No foreign keys: the trail must outlive deleted users and actors.
+CREATE TABLE role_changes (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    actor_id UUID NOT NULL,
+    old_role VARCHAR(50) NOT NULL,
+    new_role VARCHAR(50) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_role_changes_user_id ON role_changes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_changes;
+-- +goose StatementEnd
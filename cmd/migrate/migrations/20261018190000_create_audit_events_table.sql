@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- No foreign keys: the trail must outlive deleted users and actors.
+CREATE TABLE audit_events (
+    id UUID NOT NULL PRIMARY KEY,
+    action VARCHAR(64) NOT NULL,
+    actor_id UUID,
+    target_id UUID,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    before_state JSONB,
+    after_state JSONB,
+    metadata JSONB,
+    occurred_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at DESC, id DESC);
+CREATE INDEX idx_audit_events_actor_id ON audit_events (actor_id, occurred_at DESC);
+CREATE INDEX idx_audit_events_target_id ON audit_events (target_id, occurred_at DESC);
+CREATE INDEX idx_audit_events_action ON audit_events (action, occurred_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
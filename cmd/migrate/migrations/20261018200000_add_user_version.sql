@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Incremented by every update; guards against lost concurrent writes.
+ALTER TABLE users ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN version;
+-- +goose StatementEnd
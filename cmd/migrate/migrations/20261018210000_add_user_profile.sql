@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN display_name VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN avatar_key TEXT NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN locale VARCHAR(8) NOT NULL DEFAULT 'en',
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN bio,
+    DROP COLUMN timezone,
+    DROP COLUMN locale,
+    DROP COLUMN avatar_url,
+    DROP COLUMN avatar_key,
+    DROP COLUMN display_name;
+-- +goose StatementEnd
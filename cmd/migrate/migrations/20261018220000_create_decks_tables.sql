@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Decks and terms are soft-deleted because review history refers to them.
+-- A deck outlives its owner; admins can still manage it.
+CREATE TABLE decks (
+    id UUID NOT NULL PRIMARY KEY,
+    owner_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_decks_owner_id ON decks (owner_id, updated_at DESC) WHERE deleted_at IS NULL;
+
+-- position keeps the study order within the deck.
+CREATE TABLE terms (
+    id UUID NOT NULL PRIMARY KEY,
+    deck_id UUID NOT NULL REFERENCES decks (id) ON DELETE RESTRICT,
+    position INTEGER NOT NULL,
+    front TEXT NOT NULL,
+    back TEXT NOT NULL,
+    examples TEXT[] NOT NULL DEFAULT '{}',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    media JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_terms_deck_id ON terms (deck_id, position) WHERE deleted_at IS NULL;
+CREATE INDEX idx_terms_tags ON terms USING GIN (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE terms;
+DROP TABLE decks;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE review_states (
+    student_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    term_id UUID NOT NULL REFERENCES terms (id) ON DELETE RESTRICT,
+    deck_id UUID NOT NULL,
+    ease DOUBLE PRECISION NOT NULL,
+    interval_days INTEGER NOT NULL,
+    stability DOUBLE PRECISION NOT NULL DEFAULT 0,
+    difficulty DOUBLE PRECISION NOT NULL DEFAULT 0,
+    reps INTEGER NOT NULL DEFAULT 0,
+    lapses INTEGER NOT NULL DEFAULT 0,
+    due_at TIMESTAMP NOT NULL,
+    last_reviewed_at TIMESTAMP,
+    PRIMARY KEY (student_id, term_id)
+);
+
+CREATE INDEX idx_review_states_due ON review_states (student_id, due_at);
+CREATE INDEX idx_review_states_deck ON review_states (student_id, deck_id, due_at);
+
+-- One row per answered card; kept when the term is edited or retired so
+-- analytics see the full history. Terms are soft-deleted, so RESTRICT only
+-- guards against removing them by hand.
+CREATE TABLE review_logs (
+    id UUID NOT NULL PRIMARY KEY,
+    student_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    term_id UUID NOT NULL REFERENCES terms (id) ON DELETE RESTRICT,
+    deck_id UUID NOT NULL,
+    grade SMALLINT NOT NULL,
+    algorithm VARCHAR(16) NOT NULL,
+    interval_days INTEGER NOT NULL,
+    next_interval_days INTEGER NOT NULL,
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    reviewed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_review_logs_student ON review_logs (student_id, reviewed_at);
+CREATE INDEX idx_review_logs_term ON review_logs (term_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE review_logs;
+DROP TABLE review_states;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cohorts (
+    id UUID NOT NULL PRIMARY KEY,
+    mentor_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    join_code VARCHAR(16) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_cohorts_mentor_id ON cohorts (mentor_id);
+
+CREATE TABLE cohort_members (
+    cohort_id UUID NOT NULL REFERENCES cohorts (id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    joined_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (cohort_id, student_id)
+);
+
+CREATE INDEX idx_cohort_members_student_id ON cohort_members (student_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cohort_members;
+DROP TABLE cohorts;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE assignments (
+    id UUID NOT NULL PRIMARY KEY,
+    cohort_id UUID NOT NULL REFERENCES cohorts (id) ON DELETE CASCADE,
+    deck_id UUID NOT NULL REFERENCES decks (id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    due_at TIMESTAMP NOT NULL,
+    target_percent SMALLINT NOT NULL CHECK (target_percent BETWEEN 1 AND 100),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_assignments_cohort_id ON assignments (cohort_id, due_at);
+CREATE INDEX idx_assignments_deck_id ON assignments (deck_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE assignments;
+-- +goose StatementEnd
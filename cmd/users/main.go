@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"trainer/internal/app"
+	"trainer/internal/application/dto"
+	"trainer/internal/config"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/database"
+)
+
+const usage = `Usage:
+  users import [-format csv|json] [-dry-run] <file|->
+  users export [-format csv|json] [-role role] [-o file]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	cmd, args := os.Args[1], os.Args[2:]
+	if cmd != "import" && cmd != "export" {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, &cfg.Database)
+	if err != nil {
+		log.Fatalf("db connect: %v", err)
+	}
+	defer db.Close()
+
+	c, err := app.NewContainer(db, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if cmd == "import" {
+		err = importUsers(ctx, c, args)
+	} else {
+		err = exportUsers(ctx, c, args)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func importUsers(ctx context.Context, c *app.Container, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	format := flags.String("format", "", "csv or json; defaults to the file extension")
+	dryRun := flags.Bool("dry-run", false, "validate the file without creating users")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("expected one file argument\n%s", usage)
+	}
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	resp, err := c.ImportUsersUC.Execute(ctx, dto.ImportUsersRequest{
+		Actor:  user.SystemActor,
+		Format: *format,
+		DryRun: *dryRun,
+		Data:   in,
+	})
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(resp); err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("%d of %d rows rejected, nothing imported", len(resp.Errors), resp.Total)
+	}
+
+	return nil
+}
+
+func exportUsers(ctx context.Context, c *app.Container, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	format := flags.String("format", dto.FormatCSV, "csv or json")
+	role := flags.String("role", "", "only export users with this role")
+	output := flags.String("o", "", "output file; defaults to stdout")
+	flags.Parse(args)
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	err := c.ExportUsersUC.Execute(ctx, dto.ExportUsersRequest{
+		Actor:  user.SystemActor,
+		Format: *format,
+		Role:   *role,
+	}, w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
@@ -1,61 +1,259 @@
 package app
 
 import (
-	"log"
-	"os"
-	"strconv"
-	"time"
 	"trainer/internal/application"
 	"trainer/internal/application/usecase"
+	"trainer/internal/config"
+	"trainer/internal/domain/review"
 	"trainer/internal/domain/user"
 	"trainer/internal/infrastructure"
 	"trainer/internal/infrastructure/database"
+	"trainer/internal/infrastructure/memory"
 )
 
 type Container struct {
-	DB             *database.DB
-	TokenManager   application.TokenManager
-	AccessTokenUC  *usecase.AccessToken
-	RefreshTokenUC *usecase.RefreshToken
-	CreateUserUC   *usecase.CreateUser
-	UpdateUserUC   *usecase.UpdateUser
-	DeleteUserUC   *usecase.DeleteUser
-	GetUserUC      *usecase.GetUser
-	ListUserUC     *usecase.ListUser
+	DB              *database.DB
+	TokenManager    application.TokenManager
+	KeySet          application.KeySet
+	Denylist        application.TokenDenylist
+	AccessTokenUC   *usecase.AccessToken
+	RefreshTokenUC  *usecase.RefreshToken
+	LogoutUC        *usecase.Logout
+	LogoutAllUC     *usecase.LogoutAll
+	CreateUserUC    *usecase.CreateUser
+	UpdateUserUC    *usecase.UpdateUser
+	DeleteUserUC    *usecase.DeleteUser
+	RestoreUserUC   *usecase.RestoreUser
+	PurgeUsersUC    *usecase.PurgeDeletedUsers
+	PurgeTokensUC   *usecase.PurgeExpiredTokens
+	GetUserUC       *usecase.GetUser
+	ListUserUC      *usecase.ListUser
+	SearchUsersUC   *usecase.SearchUsers
+	ListSessionsUC  *usecase.ListSessions
+	RevokeSessionUC *usecase.RevokeSession
+	RequestResetUC  *usecase.RequestPasswordReset
+	ConfirmResetUC  *usecase.ConfirmPasswordReset
+	VerifyEmailUC   *usecase.VerifyEmail
+	ResendVerifyUC  *usecase.ResendVerification
+	UnlockUserUC    *usecase.UnlockUser
+	StartTOTPUC     *usecase.StartTOTP
+	ConfirmTOTPUC   *usecase.ConfirmTOTP
+	DisableTOTPUC   *usecase.DisableTOTP
+	CompleteMFAUC   *usecase.CompleteMFA
+	ChangeRoleUC    *usecase.ChangeRole
+	RoleChangesUC   *usecase.ListRoleChanges
+	ImportUsersUC   *usecase.ImportUsers
+	ExportUsersUC   *usecase.ExportUsers
+	AuditEventsUC   *usecase.ListAuditEvents
+	ExportAuditUC   *usecase.ExportAuditEvents
+	UpdateProfileUC *usecase.UpdateProfile
+	ChangePassUC    *usecase.ChangePassword
+	ChangeEmailUC   *usecase.ChangeEmail
+	DeleteAccountUC *usecase.DeleteAccount
+	UploadAvatarUC  *usecase.UploadAvatar
+	DeleteAvatarUC  *usecase.DeleteAvatar
+	CreateDeckUC    *usecase.CreateDeck
+	GetDeckUC       *usecase.GetDeck
+	ListDecksUC     *usecase.ListDecks
+	UpdateDeckUC    *usecase.UpdateDeck
+	DeleteDeckUC    *usecase.DeleteDeck
+	AddTermUC       *usecase.AddTerm
+	UpdateTermUC    *usecase.UpdateTerm
+	DeleteTermUC    *usecase.DeleteTerm
+	DueReviewsUC    *usecase.ListDueReviews
+	SubmitReviewUC  *usecase.SubmitReview
+	CreateCohortUC  *usecase.CreateCohort
+	GetCohortUC     *usecase.GetCohort
+	ListCohortsUC   *usecase.ListCohorts
+	UpdateCohortUC  *usecase.UpdateCohort
+	DeleteCohortUC  *usecase.DeleteCohort
+	RotateCodeUC    *usecase.RotateJoinCode
+	InviteMemberUC  *usecase.InviteCohortMember
+	RemoveMemberUC  *usecase.RemoveCohortMember
+	JoinCohortUC    *usecase.JoinCohort
+	LeaveCohortUC   *usecase.LeaveCohort
+	MyCohortsUC     *usecase.ListMyCohorts
+	CreateAssignUC  *usecase.CreateAssignment
+	GetAssignUC     *usecase.GetAssignment
+	ListAssignsUC   *usecase.ListAssignments
+	UpdateAssignUC  *usecase.UpdateAssignment
+	DeleteAssignUC  *usecase.DeleteAssignment
+	MyAssignsUC     *usecase.ListMyAssignments
+	StudentStatsUC  *usecase.GetStudentAnalytics
+	ExportStudentUC *usecase.ExportStudentAnalytics
+	CohortStatsUC   *usecase.GetCohortAnalytics
+	ExportCohortUC  *usecase.ExportCohortAnalytics
 }
 
-func NewContainer(db *database.DB) (*Container, error) {
+func NewContainer(db *database.DB, cfg *config.Config) (*Container, error) {
 	userRepo := database.NewUserRepository(db)
+	resetRepo := database.NewPasswordResetRepository(db)
+	verificationRepo := database.NewEmailVerificationRepository(db)
+	challengeRepo := database.NewMFAChallengeRepository(db)
+	roleChangeRepo := database.NewRoleChangeRepository(db)
+	auditRepo := database.NewAuditEventRepository(db)
+	deckRepo := database.NewDeckRepository(db)
+	reviewRepo := database.NewReviewRepository(db)
+	cohortRepo := database.NewCohortRepository(db)
+	assignmentRepo := database.NewAssignmentRepository(db)
+	analyticsRepo := database.NewAnalyticsRepository(db)
 
-	passwordHasher := infrastructure.NewBcryptHasher(10)
+	var attemptRepo user.LoginAttemptRepository
+	if cfg.Lockout.Store == "memory" {
+		attemptRepo = memory.NewLoginAttemptRepository()
+	} else {
+		attemptRepo = database.NewLoginAttemptRepository(db)
+	}
+
+	passwordHasher := infrastructure.NewBcryptHasher(cfg.Password.BcryptCost)
 
-	durationMinutes, err := strconv.Atoi(os.Getenv("JWT_DURATION_IN_MINUTE"))
+	tokenManager, err := newTokenManager(cfg.JWT)
 	if err != nil {
-		log.Fatalf("invalid JWT_DURATION_IN_MINUTE: %v", err)
+		return nil, err
+	}
+
+	denylist := infrastructure.NewTokenDenylist(database.NewRevokedAccessRepository(db), cfg.JWT.TTL, cfg.JWT.DenylistSync)
+
+	mailer := infrastructure.NewFileMailer(cfg.Mail.From, cfg.Mail.Dir)
+
+	auditor := infrastructure.NewAuditor(auditRepo)
+
+	storage := infrastructure.NewLocalStorage(cfg.Storage.Dir, cfg.Storage.URL)
+
+	mfaRequiredRoles := make([]user.Role, len(cfg.MFA.RequiredRoles))
+	for i, role := range cfg.MFA.RequiredRoles {
+		mfaRequiredRoles[i] = user.Role(role)
 	}
-	tokenManager := infrastructure.NewJwtManager(os.Getenv("JWT_SECRET"), time.Duration(durationMinutes)*time.Minute)
 
-	userService := user.NewService(userRepo, passwordHasher, time.Hour*24*30)
+	userService := user.NewService(userRepo, passwordHasher, user.Policy{
+		RefreshTokenTTL:      cfg.RefreshToken.TTL,
+		PasswordResetTTL:     cfg.PasswordReset.TTL,
+		EmailVerificationTTL: cfg.Verification.TTL,
+		EmailVerification:    cfg.Verification.Policy,
+		MFAChallengeTTL:      cfg.MFA.ChallengeTTL,
+		MFAIssuer:            cfg.MFA.Issuer,
+		MFARequiredRoles:     mfaRequiredRoles,
+		DeletionRetention:    cfg.Deletion.Retention,
+	})
+
+	loginGuard := user.NewLoginGuard(attemptRepo, user.LockoutPolicy{
+		FreeAttempts:       cfg.Lockout.FreeAttempts,
+		BaseDelay:          cfg.Lockout.BaseDelay,
+		MaxAccountFailures: cfg.Lockout.MaxAccountFailures,
+		MaxIPFailures:      cfg.Lockout.MaxIPFailures,
+		LockoutDuration:    cfg.Lockout.Duration,
+	})
+
+	scheduler, err := review.NewScheduler(cfg.Review.Algorithm, cfg.Review.DesiredRetention, cfg.Review.MaxIntervalDays)
+	if err != nil {
+		return nil, err
+	}
+	reviewService := review.NewService(scheduler, review.SystemClock{})
 
-	accessTokenUC := usecase.NewAccessToken(userService, userRepo, tokenManager)
-	refreshTokenUC := usecase.NewRefreshToken(userService, userRepo, tokenManager)
-	createUserUC := usecase.NewCreateUser(userService, userRepo)
-	updateUserUC := usecase.NewUpdateUser(userService, userRepo)
-	deleteUserUC := usecase.NewDeleteUser(userRepo)
-	getUserUC := usecase.NewGetUser(userRepo)
-	listUserUC := usecase.NewListUser(userRepo)
+	studentAnalytics := usecase.NewGetStudentAnalytics(analyticsRepo, userRepo, cohortRepo)
+	cohortAnalytics := usecase.NewGetCohortAnalytics(analyticsRepo, cohortRepo)
+
+	verificationMailer := usecase.NewVerificationMailer(userService, verificationRepo, mailer, cfg.App.URL)
 
 	c := Container{
-		db,
-		tokenManager,
-		accessTokenUC,
-		refreshTokenUC,
-		createUserUC,
-		updateUserUC,
-		deleteUserUC,
-		getUserUC,
-		listUserUC,
+		DB:              db,
+		TokenManager:    tokenManager,
+		KeySet:          tokenManager,
+		Denylist:        denylist,
+		AccessTokenUC:   usecase.NewAccessToken(userService, userRepo, challengeRepo, tokenManager, loginGuard, auditor),
+		RefreshTokenUC:  usecase.NewRefreshToken(userService, userRepo, tokenManager, denylist, auditor),
+		LogoutUC:        usecase.NewLogout(userService, userRepo, denylist),
+		LogoutAllUC:     usecase.NewLogoutAll(userService, userRepo, denylist),
+		CreateUserUC:    usecase.NewCreateUser(userService, userRepo, verificationMailer, auditor),
+		UpdateUserUC:    usecase.NewUpdateUser(userService, userRepo, verificationMailer, denylist, auditor),
+		DeleteUserUC:    usecase.NewDeleteUser(userService, userRepo, denylist, storage, auditRepo, auditor),
+		RestoreUserUC:   usecase.NewRestoreUser(userService, userRepo, auditor),
+		PurgeUsersUC:    usecase.NewPurgeDeletedUsers(userService, userRepo, storage, auditRepo, auditor),
+		PurgeTokensUC:   usecase.NewPurgeExpiredTokens(userRepo),
+		GetUserUC:       usecase.NewGetUser(userRepo, cohortRepo),
+		ListUserUC:      usecase.NewListUser(userRepo, cohortRepo),
+		SearchUsersUC:   usecase.NewSearchUsers(userRepo, cohortRepo),
+		ListSessionsUC:  usecase.NewListSessions(userRepo),
+		RevokeSessionUC: usecase.NewRevokeSession(userService, userRepo, denylist),
+		RequestResetUC:  usecase.NewRequestPasswordReset(userService, userRepo, resetRepo, mailer, cfg.App.URL),
+		ConfirmResetUC:  usecase.NewConfirmPasswordReset(userService, userRepo, resetRepo, denylist, auditor),
+		VerifyEmailUC:   usecase.NewVerifyEmail(userService, userRepo, verificationRepo, auditor),
+		ResendVerifyUC:  usecase.NewResendVerification(userRepo, verificationMailer),
+		UnlockUserUC:    usecase.NewUnlockUser(userRepo, loginGuard, auditor),
+		StartTOTPUC:     usecase.NewStartTOTP(userService, userRepo),
+		ConfirmTOTPUC:   usecase.NewConfirmTOTP(userService, userRepo, auditor),
+		DisableTOTPUC:   usecase.NewDisableTOTP(userService, userRepo, auditor),
+		CompleteMFAUC:   usecase.NewCompleteMFA(userService, userRepo, challengeRepo, tokenManager, loginGuard, auditor),
+		ChangeRoleUC:    usecase.NewChangeRole(userService, userRepo, denylist, auditor),
+		RoleChangesUC:   usecase.NewListRoleChanges(roleChangeRepo),
+		ImportUsersUC:   usecase.NewImportUsers(userService, userRepo, verificationMailer, auditor),
+		ExportUsersUC:   usecase.NewExportUsers(userRepo),
+		AuditEventsUC:   usecase.NewListAuditEvents(auditRepo),
+		ExportAuditUC:   usecase.NewExportAuditEvents(auditRepo),
+		UpdateProfileUC: usecase.NewUpdateProfile(userService, userRepo, auditor),
+		ChangePassUC:    usecase.NewChangePassword(userService, userRepo, denylist, auditor),
+		ChangeEmailUC:   usecase.NewChangeEmail(userService, userRepo, verificationMailer, mailer, auditor),
+		DeleteAccountUC: usecase.NewDeleteAccount(userService, userRepo, denylist, auditor),
+		UploadAvatarUC:  usecase.NewUploadAvatar(userService, userRepo, storage),
+		DeleteAvatarUC:  usecase.NewDeleteAvatar(userService, userRepo, storage),
+		CreateDeckUC:    usecase.NewCreateDeck(deckRepo),
+		GetDeckUC:       usecase.NewGetDeck(deckRepo),
+		ListDecksUC:     usecase.NewListDecks(deckRepo),
+		UpdateDeckUC:    usecase.NewUpdateDeck(deckRepo),
+		DeleteDeckUC:    usecase.NewDeleteDeck(deckRepo),
+		AddTermUC:       usecase.NewAddTerm(deckRepo),
+		UpdateTermUC:    usecase.NewUpdateTerm(deckRepo),
+		DeleteTermUC:    usecase.NewDeleteTerm(deckRepo),
+		DueReviewsUC:    usecase.NewListDueReviews(reviewRepo, deckRepo, assignmentRepo, reviewService),
+		SubmitReviewUC:  usecase.NewSubmitReview(reviewRepo, deckRepo, assignmentRepo, reviewService),
+		CreateCohortUC:  usecase.NewCreateCohort(cohortRepo),
+		GetCohortUC:     usecase.NewGetCohort(cohortRepo),
+		ListCohortsUC:   usecase.NewListCohorts(cohortRepo),
+		UpdateCohortUC:  usecase.NewUpdateCohort(cohortRepo),
+		DeleteCohortUC:  usecase.NewDeleteCohort(cohortRepo),
+		RotateCodeUC:    usecase.NewRotateJoinCode(cohortRepo),
+		InviteMemberUC:  usecase.NewInviteCohortMember(cohortRepo, userRepo, mailer, cfg.App.URL),
+		RemoveMemberUC:  usecase.NewRemoveCohortMember(cohortRepo),
+		JoinCohortUC:    usecase.NewJoinCohort(cohortRepo, userRepo),
+		LeaveCohortUC:   usecase.NewLeaveCohort(cohortRepo),
+		MyCohortsUC:     usecase.NewListMyCohorts(cohortRepo),
+		CreateAssignUC:  usecase.NewCreateAssignment(assignmentRepo, cohortRepo, deckRepo),
+		GetAssignUC:     usecase.NewGetAssignment(assignmentRepo, cohortRepo),
+		ListAssignsUC:   usecase.NewListAssignments(assignmentRepo, cohortRepo),
+		UpdateAssignUC:  usecase.NewUpdateAssignment(assignmentRepo, cohortRepo),
+		DeleteAssignUC:  usecase.NewDeleteAssignment(assignmentRepo, cohortRepo),
+		MyAssignsUC:     usecase.NewListMyAssignments(assignmentRepo),
+		StudentStatsUC:  studentAnalytics,
+		ExportStudentUC: usecase.NewExportStudentAnalytics(studentAnalytics),
+		CohortStatsUC:   cohortAnalytics,
+		ExportCohortUC:  usecase.NewExportCohortAnalytics(cohortAnalytics, userRepo),
 	}
 
 	return &c, nil
 }
+
+// hmacKeyID names the single key used when tokens are signed with JWT_SECRET.
+const hmacKeyID = "default"
+
+func newTokenManager(cfg config.JWTConfig) (*infrastructure.JwtManager, error) {
+	options := infrastructure.JwtOptions{
+		Issuer:   cfg.Issuer,
+		Audience: cfg.Audience,
+		TTL:      cfg.TTL,
+	}
+
+	if cfg.KeysDir == "" {
+		options.Keys = []infrastructure.JwtKey{infrastructure.NewHMACKey(hmacKeyID, cfg.Secret)}
+		options.ActiveKeyID = hmacKeyID
+		return infrastructure.NewJwtManager(options)
+	}
+
+	keys, err := infrastructure.LoadJwtKeys(cfg.KeysDir)
+	if err != nil {
+		return nil, err
+	}
+	options.Keys = keys
+	options.ActiveKeyID = cfg.ActiveKeyID
+
+	return infrastructure.NewJwtManager(options)
+}
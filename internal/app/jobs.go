@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPeriodically calls job every interval until ctx is cancelled. Errors are
+// logged and the job is retried on the next tick.
+func RunPeriodically(ctx context.Context, name string, interval time.Duration, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("job %s: %v", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
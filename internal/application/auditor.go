@@ -0,0 +1,13 @@
+package application
+
+import (
+	"context"
+	"trainer/internal/domain/audit"
+)
+
+// Auditor records security-relevant actions. An audit failure must not undo
+// or fail the action it describes, so implementations report errors
+// themselves.
+type Auditor interface {
+	Record(ctx context.Context, event *audit.Event)
+}
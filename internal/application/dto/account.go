@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"io"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// The requests below act on the account of the authenticated caller.
+
+// UpdateProfileRequest is a partial update: omitted fields keep their value,
+// and empty names are ignored.
+type UpdateProfileRequest struct {
+	Actor       user.Actor `json:"-"`
+	FirstName   string     `json:"first_name"`
+	LastName    string     `json:"last_name"`
+	DisplayName *string    `json:"display_name"`
+	Locale      *string    `json:"locale"`
+	Timezone    *string    `json:"timezone"`
+	Bio         *string    `json:"bio"`
+	IfMatch     []int      `json:"-"`
+}
+
+// UploadAvatarRequest carries the image; ContentType is sniffed from the
+// content rather than trusted from the client.
+type UploadAvatarRequest struct {
+	Actor       user.Actor `json:"-"`
+	ContentType string     `validate:"required" json:"-"`
+	Content     io.Reader  `validate:"required" json:"-"`
+}
+
+type DeleteAvatarRequest struct {
+	Actor user.Actor `json:"-"`
+}
+
+type ChangePasswordRequest struct {
+	Actor           user.Actor `json:"-"`
+	SessionID       uuid.UUID  `json:"-"`
+	CurrentPassword string     `validate:"required" json:"current_password"`
+	NewPassword     string     `validate:"required" json:"new_password"`
+}
+
+type ChangeEmailRequest struct {
+	Actor    user.Actor `json:"-"`
+	Email    string     `validate:"required,email" json:"email"`
+	Password string     `validate:"required" json:"password"`
+}
+
+type DeleteAccountRequest struct {
+	Actor    user.Actor `json:"-"`
+	Password string     `validate:"required" json:"password"`
+}
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/user"
+)
+
+// AnalyticsFilter is read from query parameters. From and To are dates,
+// both included, in the time zone Timezone (UTC by default); the range
+// defaults to the last 30 days.
+type AnalyticsFilter struct {
+	From     string `validate:"omitempty,datetime=2006-01-02" json:"from"`
+	To       string `validate:"omitempty,datetime=2006-01-02" json:"to"`
+	Timezone string `validate:"max=64" json:"tz"`
+}
+
+type StudentAnalyticsRequest struct {
+	AnalyticsFilter
+	Actor     user.Actor `json:"-"`
+	StudentId string     `validate:"required" json:"-"`
+}
+
+type CohortAnalyticsRequest struct {
+	AnalyticsFilter
+	Actor    user.Actor `json:"-"`
+	CohortId string     `validate:"required" json:"-"`
+}
+
+type AnalyticsTotalsResponse struct {
+	Reviews       int `json:"reviews"`
+	RecallReviews int `json:"recall_reviews"`
+	// RetentionRate is null when no learned term was reviewed.
+	RetentionRate *float64 `json:"retention_rate"`
+	TimeSpentMs   int64    `json:"time_spent_ms"`
+}
+
+type AnalyticsDayResponse struct {
+	Date string `json:"date"`
+	AnalyticsTotalsResponse
+	ActiveStudents int `json:"active_students"`
+}
+
+type StudentSummaryResponse struct {
+	StudentId string `json:"student_id"`
+	AnalyticsTotalsResponse
+	ActiveDays     int        `json:"active_days"`
+	CurrentStreak  int        `json:"current_streak"`
+	LongestStreak  int        `json:"longest_streak"`
+	LastReviewedAt *time.Time `json:"last_reviewed_at"`
+}
+
+type HardTermResponse struct {
+	TermId      string  `json:"term_id"`
+	DeckId      string  `json:"deck_id"`
+	Front       string  `json:"front"`
+	Reviews     int     `json:"reviews"`
+	Failures    int     `json:"failures"`
+	FailureRate float64 `json:"failure_rate"`
+}
+
+type AnalyticsRangeResponse struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Timezone string `json:"tz"`
+}
+
+type StudentAnalyticsResponse struct {
+	AnalyticsRangeResponse
+	Summary      *StudentSummaryResponse `json:"summary"`
+	Days         []*AnalyticsDayResponse `json:"days"`
+	HardestTerms []*HardTermResponse     `json:"hardest_terms"`
+}
+
+type CohortSummaryResponse struct {
+	AnalyticsTotalsResponse
+	Students       int `json:"students"`
+	ActiveStudents int `json:"active_students"`
+}
+
+type CohortAnalyticsResponse struct {
+	AnalyticsRangeResponse
+	CohortId     string                    `json:"cohort_id"`
+	Summary      *CohortSummaryResponse    `json:"summary"`
+	Days         []*AnalyticsDayResponse   `json:"days"`
+	HardestTerms []*HardTermResponse       `json:"hardest_terms"`
+	Students     []*StudentSummaryResponse `json:"students"`
+}
+
+func NewAnalyticsRangeResponse(r analytics.Range) AnalyticsRangeResponse {
+	return AnalyticsRangeResponse{
+		From:     r.From.Format(time.DateOnly),
+		To:       r.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		Timezone: r.Location.String(),
+	}
+}
+
+func NewAnalyticsTotalsResponse(t analytics.Totals) AnalyticsTotalsResponse {
+	resp := AnalyticsTotalsResponse{
+		Reviews:       t.Reviews,
+		RecallReviews: t.RecallReviews,
+		TimeSpentMs:   t.TimeSpent.Milliseconds(),
+	}
+	if rate, ok := t.RetentionRate(); ok {
+		resp.RetentionRate = &rate
+	}
+	return resp
+}
+
+func NewAnalyticsDaysResponse(days []*analytics.Day) []*AnalyticsDayResponse {
+	resp := make([]*AnalyticsDayResponse, len(days))
+	for i, d := range days {
+		resp[i] = &AnalyticsDayResponse{
+			Date:                    d.Date.Format(time.DateOnly),
+			AnalyticsTotalsResponse: NewAnalyticsTotalsResponse(d.Totals),
+			ActiveStudents:          d.ActiveStudents,
+		}
+	}
+	return resp
+}
+
+func NewStudentSummaryResponse(s *analytics.StudentSummary) *StudentSummaryResponse {
+	return &StudentSummaryResponse{
+		StudentId:               s.StudentID.String(),
+		AnalyticsTotalsResponse: NewAnalyticsTotalsResponse(s.Totals),
+		ActiveDays:              s.ActiveDays,
+		CurrentStreak:           s.CurrentStreak,
+		LongestStreak:           s.LongestStreak,
+		LastReviewedAt:          s.LastReviewedAt,
+	}
+}
+
+func NewHardTermsResponse(terms []*analytics.TermStats) []*HardTermResponse {
+	resp := make([]*HardTermResponse, len(terms))
+	for i, t := range terms {
+		resp[i] = &HardTermResponse{
+			TermId:      t.TermID.String(),
+			DeckId:      t.DeckID.String(),
+			Front:       t.Front,
+			Reviews:     t.Reviews,
+			Failures:    t.Failures,
+			FailureRate: t.FailureRate(),
+		}
+	}
+	return resp
+}
+
+// NewCohortSummaryResponse adds up the summaries of the cohort's students.
+func NewCohortSummaryResponse(students []*analytics.StudentSummary) *CohortSummaryResponse {
+	var (
+		totals analytics.Totals
+		active int
+	)
+	for _, s := range students {
+		totals.Reviews += s.Reviews
+		totals.RecallReviews += s.RecallReviews
+		totals.Recalled += s.Recalled
+		totals.TimeSpent += s.TimeSpent
+		if s.Reviews > 0 {
+			active++
+		}
+	}
+
+	return &CohortSummaryResponse{
+		AnalyticsTotalsResponse: NewAnalyticsTotalsResponse(totals),
+		Students:                len(students),
+		ActiveStudents:          active,
+	}
+}
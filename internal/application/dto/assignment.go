@@ -0,0 +1,134 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/user"
+)
+
+// CreateAssignmentRequest assigns a deck to a cohort. The title defaults to
+// the deck's.
+type CreateAssignmentRequest struct {
+	Actor         user.Actor `json:"-"`
+	CohortId      string     `validate:"required" json:"-"`
+	DeckId        string     `validate:"required,uuid" json:"deck_id"`
+	Title         string     `validate:"max=200" json:"title"`
+	DueAt         string     `validate:"required,datetime=2006-01-02T15:04:05Z07:00" json:"due_at"`
+	TargetPercent int        `validate:"required,min=1,max=100" json:"target_percent"`
+}
+
+type UpdateAssignmentRequest struct {
+	Actor         user.Actor `json:"-"`
+	Id            string     `validate:"required" json:"-"`
+	Title         string     `validate:"required,max=200" json:"title"`
+	DueAt         string     `validate:"required,datetime=2006-01-02T15:04:05Z07:00" json:"due_at"`
+	TargetPercent int        `validate:"required,min=1,max=100" json:"target_percent"`
+}
+
+type GetAssignmentRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type DeleteAssignmentRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type ListAssignmentsRequest struct {
+	Actor    user.Actor `json:"-"`
+	CohortId string     `validate:"required" json:"-"`
+}
+
+type MyAssignmentsRequest struct {
+	Actor user.Actor `json:"-"`
+}
+
+type AssignmentResponse struct {
+	Id            string    `json:"id"`
+	CohortId      string    `json:"cohort_id"`
+	DeckId        string    `json:"deck_id"`
+	Title         string    `json:"title"`
+	DueAt         time.Time `json:"due_at"`
+	TargetPercent int       `json:"target_percent"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type AssignmentProgressResponse struct {
+	StudentId      string     `json:"student_id"`
+	Status         string     `json:"status"`
+	TotalTerms     int        `json:"total_terms"`
+	ReviewedTerms  int        `json:"reviewed_terms"`
+	MasteredTerms  int        `json:"mastered_terms"`
+	MasteryPercent float64    `json:"mastery_percent"`
+	LastReviewedAt *time.Time `json:"last_reviewed_at"`
+}
+
+// AssignmentDetailResponse is an assignment with the progress of every
+// student in the cohort.
+type AssignmentDetailResponse struct {
+	*AssignmentResponse
+	Students []*AssignmentProgressResponse `json:"students"`
+}
+
+type ListAssignmentsResponse struct {
+	Assignments []*AssignmentResponse `json:"assignments"`
+}
+
+// StudentAssignmentResponse is an assignment with the progress of the
+// student reading it.
+type StudentAssignmentResponse struct {
+	*AssignmentResponse
+	Progress *AssignmentProgressResponse `json:"progress"`
+}
+
+type MyAssignmentsResponse struct {
+	Assignments []*StudentAssignmentResponse `json:"assignments"`
+}
+
+func NewAssignmentResponse(a *assignment.Assignment) *AssignmentResponse {
+	return &AssignmentResponse{
+		Id:            a.ID.String(),
+		CohortId:      a.CohortID.String(),
+		DeckId:        a.DeckID.String(),
+		Title:         a.Title,
+		DueAt:         a.DueAt,
+		TargetPercent: a.TargetPercent,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
+func NewAssignmentProgressResponse(a *assignment.Assignment, p *assignment.Progress, now time.Time) *AssignmentProgressResponse {
+	return &AssignmentProgressResponse{
+		StudentId:      p.StudentID.String(),
+		Status:         string(a.StatusOf(p, now)),
+		TotalTerms:     p.TotalTerms,
+		ReviewedTerms:  p.ReviewedTerms,
+		MasteredTerms:  p.MasteredTerms,
+		MasteryPercent: p.MasteryPercent(),
+		LastReviewedAt: p.LastReviewedAt,
+	}
+}
+
+func NewAssignmentDetailResponse(a *assignment.Assignment, progress []*assignment.Progress, now time.Time) *AssignmentDetailResponse {
+	students := make([]*AssignmentProgressResponse, len(progress))
+	for i, p := range progress {
+		students[i] = NewAssignmentProgressResponse(a, p, now)
+	}
+
+	return &AssignmentDetailResponse{
+		AssignmentResponse: NewAssignmentResponse(a),
+		Students:           students,
+	}
+}
+
+func NewAssignmentsResponse(assignments []*assignment.Assignment) *ListAssignmentsResponse {
+	resp := make([]*AssignmentResponse, len(assignments))
+	for i, a := range assignments {
+		resp[i] = NewAssignmentResponse(a)
+	}
+
+	return &ListAssignmentsResponse{Assignments: resp}
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// AuditEventFilter is read from query parameters; empty fields match all.
+type AuditEventFilter struct {
+	Action   string `validate:"max=64" json:"action"`
+	ActorID  string `validate:"omitempty,uuid" json:"actor_id"`
+	TargetID string `validate:"omitempty,uuid" json:"target_id"`
+	From     string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" json:"from"`
+	To       string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" json:"to"`
+}
+
+type ListAuditEventsRequest struct {
+	AuditEventFilter
+	Actor  user.Actor `json:"-"`
+	Cursor string     `json:"cursor"`
+	Limit  int        `validate:"min=0,max=100" json:"limit"`
+}
+
+type ExportAuditEventsRequest struct {
+	AuditEventFilter
+	Actor user.Actor `json:"-"`
+}
+
+type AuditEventResponse struct {
+	ID         string         `json:"id"`
+	Action     string         `json:"action"`
+	ActorID    string         `json:"actor_id,omitempty"`
+	TargetID   string         `json:"target_id,omitempty"`
+	IP         string         `json:"ip"`
+	UserAgent  string         `json:"user_agent"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	Metadata   map[string]any `json:"metadata,omitempty"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+type ListAuditEventsResponse struct {
+	Events     []*AuditEventResponse `json:"events"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+func NewAuditEventResponse(e *audit.Event) *AuditEventResponse {
+	return &AuditEventResponse{
+		ID:         e.ID.String(),
+		Action:     string(e.Action),
+		ActorID:    optionalID(e.ActorID),
+		TargetID:   optionalID(e.TargetID),
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		Before:     e.Before,
+		After:      e.After,
+		Metadata:   e.Metadata,
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+func NewAuditEventsResponse(page *audit.Page) *ListAuditEventsResponse {
+	events := make([]*AuditEventResponse, len(page.Events))
+	for i, e := range page.Events {
+		events[i] = NewAuditEventResponse(e)
+	}
+
+	resp := &ListAuditEventsResponse{Events: events}
+	if page.Next != nil {
+		resp.NextCursor = page.Next.Encode()
+	}
+
+	return resp
+}
+
+func optionalID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
@@ -1,19 +1,51 @@
 package dto
 
-import "trainer/internal/domain/user"
+import (
+	"time"
+	"trainer/internal/domain/user"
+)
 
 type AccessTokenRequest struct {
-	Email    string `validate:"required,email" json:"email"`
-	Password string `validate:"required" json:"password"`
+	Email     string `validate:"required,email" json:"email"`
+	Password  string `validate:"required" json:"password"`
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
 }
 type RefreshTokenRequest struct {
 	RefreshToken string `validate:"required" json:"refresh_token"`
+	UserAgent    string `json:"-"`
+	IP           string `json:"-"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `validate:"required" json:"refresh_token"`
+}
+
+type LogoutAllRequest struct {
+	UserId string `validate:"required" json:"-"`
 }
 
 type TokenResponse struct {
 	AccessToken  string        `json:"access_token"`
 	RefreshToken string        `json:"refresh_token"`
 	User         *UserResponse `json:"user"`
+	// MFAEnrollmentRequired tells the client the role of the user is limited
+	// until TOTP is set up.
+	MFAEnrollmentRequired bool `json:"mfa_enrollment_required,omitempty"`
+}
+
+// LoginResponse carries either the issued tokens or, for users with MFA
+// enabled, the challenge token to complete through /auth/mfa.
+type LoginResponse struct {
+	*TokenResponse
+	MFARequired  bool       `json:"mfa_required,omitempty"`
+	MFAToken     string     `json:"mfa_token,omitempty"`
+	MFAExpiresAt *time.Time `json:"mfa_expires_at,omitempty"`
+}
+
+type CompleteMFARequest struct {
+	MFAToken string `validate:"required" json:"mfa_token"`
+	Code     string `validate:"required" json:"code"`
 }
 
 func NewTokenResponse(accessToken string, refreshToken string, user *user.User) *TokenResponse {
@@ -23,3 +55,11 @@ func NewTokenResponse(accessToken string, refreshToken string, user *user.User)
 		User:         NewUserResponse(user),
 	}
 }
+
+func NewMFAChallengeResponse(token string, challenge *user.MFAChallenge) *LoginResponse {
+	return &LoginResponse{
+		MFARequired:  true,
+		MFAToken:     token,
+		MFAExpiresAt: &challenge.ExpiresAt,
+	}
+}
@@ -0,0 +1,163 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+)
+
+type CreateCohortRequest struct {
+	Actor       user.Actor `json:"-"`
+	Name        string     `validate:"required,max=200" json:"name"`
+	Description string     `validate:"max=2000" json:"description"`
+}
+
+type UpdateCohortRequest struct {
+	Actor       user.Actor `json:"-"`
+	Id          string     `validate:"required" json:"-"`
+	Name        string     `validate:"required,max=200" json:"name"`
+	Description string     `validate:"max=2000" json:"description"`
+}
+
+type GetCohortRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type DeleteCohortRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+// ListCohortsRequest is read from query parameters. Mentors only see their
+// own cohorts; admins see every cohort unless they filter by mentor.
+type ListCohortsRequest struct {
+	Actor    user.Actor `json:"-"`
+	MentorId string     `validate:"omitempty,uuid" json:"mentor_id"`
+}
+
+type RotateJoinCodeRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+// InviteCohortMemberRequest sends the join code to a student by email.
+type InviteCohortMemberRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+	Email string     `validate:"required,email" json:"email"`
+}
+
+type RemoveCohortMemberRequest struct {
+	Actor     user.Actor `json:"-"`
+	Id        string     `validate:"required" json:"-"`
+	StudentId string     `validate:"required" json:"-"`
+}
+
+type JoinCohortRequest struct {
+	Actor user.Actor `json:"-"`
+	Code  string     `validate:"required,max=32" json:"code"`
+}
+
+type LeaveCohortRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type MyCohortsRequest struct {
+	Actor user.Actor `json:"-"`
+}
+
+type CohortMemberResponse struct {
+	StudentId string    `json:"student_id"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+type CohortResponse struct {
+	Id          string                  `json:"id"`
+	MentorId    string                  `json:"mentor_id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	JoinCode    string                  `json:"join_code"`
+	MemberCount int                     `json:"member_count"`
+	Members     []*CohortMemberResponse `json:"members,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+type ListCohortsResponse struct {
+	Cohorts []*CohortResponse `json:"cohorts"`
+}
+
+// StudentCohortResponse is a cohort as its members see it, without the
+// join code.
+type StudentCohortResponse struct {
+	Id          string `json:"id"`
+	MentorId    string `json:"mentor_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MemberCount int    `json:"member_count"`
+}
+
+type MyCohortsResponse struct {
+	Cohorts []*StudentCohortResponse `json:"cohorts"`
+}
+
+func NewCohortMemberResponse(m *cohort.Member) *CohortMemberResponse {
+	return &CohortMemberResponse{
+		StudentId: m.StudentID.String(),
+		JoinedAt:  m.JoinedAt,
+	}
+}
+
+// NewCohortResponse includes the members when they are given.
+func NewCohortResponse(c *cohort.Cohort, memberCount int, members []*cohort.Member) *CohortResponse {
+	resp := &CohortResponse{
+		Id:          c.ID.String(),
+		MentorId:    c.MentorID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		JoinCode:    c.JoinCode,
+		MemberCount: memberCount,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+
+	if members != nil {
+		resp.MemberCount = len(members)
+		resp.Members = make([]*CohortMemberResponse, len(members))
+		for i, m := range members {
+			resp.Members[i] = NewCohortMemberResponse(m)
+		}
+	}
+
+	return resp
+}
+
+func NewCohortsResponse(summaries []*cohort.Summary) *ListCohortsResponse {
+	cohorts := make([]*CohortResponse, len(summaries))
+	for i, s := range summaries {
+		cohorts[i] = NewCohortResponse(s.Cohort, s.MemberCount, nil)
+	}
+
+	return &ListCohortsResponse{Cohorts: cohorts}
+}
+
+func NewStudentCohortResponse(c *cohort.Cohort, memberCount int) *StudentCohortResponse {
+	return &StudentCohortResponse{
+		Id:          c.ID.String(),
+		MentorId:    c.MentorID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		MemberCount: memberCount,
+	}
+}
+
+func NewMyCohortsResponse(summaries []*cohort.Summary) *MyCohortsResponse {
+	cohorts := make([]*StudentCohortResponse, len(summaries))
+	for i, s := range summaries {
+		cohorts[i] = NewStudentCohortResponse(s.Cohort, s.MemberCount)
+	}
+
+	return &MyCohortsResponse{Cohorts: cohorts}
+}
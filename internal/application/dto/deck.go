@@ -0,0 +1,185 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/user"
+)
+
+type CreateDeckRequest struct {
+	Actor       user.Actor `json:"-"`
+	Title       string     `validate:"required,max=200" json:"title"`
+	Description string     `validate:"max=2000" json:"description"`
+}
+
+type UpdateDeckRequest struct {
+	Actor       user.Actor `json:"-"`
+	Id          string     `validate:"required" json:"-"`
+	Title       string     `validate:"required,max=200" json:"title"`
+	Description string     `validate:"max=2000" json:"description"`
+}
+
+type GetDeckRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type DeleteDeckRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+// ListDecksRequest is read from query parameters. Mentors only see their own
+// decks; admins see every deck unless they filter by owner.
+type ListDecksRequest struct {
+	Actor   user.Actor `json:"-"`
+	OwnerId string     `validate:"omitempty,uuid" json:"owner_id"`
+	Search  string     `validate:"max=100" json:"search"`
+	Page    int        `validate:"omitempty,min=1" json:"page"`
+	Limit   int        `validate:"omitempty,min=1,max=100" json:"limit"`
+}
+
+type MediaRefRequest struct {
+	Kind string `validate:"required,oneof=image audio" json:"kind"`
+	URL  string `validate:"required,max=2048" json:"url"`
+}
+
+type TermRequest struct {
+	Front    string            `validate:"required,max=1000" json:"front"`
+	Back     string            `validate:"required,max=1000" json:"back"`
+	Examples []string          `validate:"max=10,dive,required,max=500" json:"examples"`
+	Tags     []string          `validate:"max=20,dive,required,max=32" json:"tags"`
+	Media    []MediaRefRequest `validate:"max=5,dive" json:"media"`
+}
+
+func (t TermRequest) Content() deck.TermContent {
+	media := make([]deck.MediaRef, len(t.Media))
+	for i, m := range t.Media {
+		media[i] = deck.MediaRef{Kind: deck.MediaKind(m.Kind), URL: m.URL}
+	}
+
+	return deck.TermContent{
+		Front:    t.Front,
+		Back:     t.Back,
+		Examples: t.Examples,
+		Tags:     t.Tags,
+		Media:    media,
+	}
+}
+
+type AddTermRequest struct {
+	TermRequest
+	Actor  user.Actor `json:"-"`
+	DeckId string     `validate:"required" json:"-"`
+}
+
+type UpdateTermRequest struct {
+	TermRequest
+	Actor  user.Actor `json:"-"`
+	DeckId string     `validate:"required" json:"-"`
+	TermId string     `validate:"required" json:"-"`
+}
+
+type DeleteTermRequest struct {
+	Actor  user.Actor `json:"-"`
+	DeckId string     `validate:"required" json:"-"`
+	TermId string     `validate:"required" json:"-"`
+}
+
+type MediaRefResponse struct {
+	Kind string `json:"kind"`
+	URL  string `json:"url"`
+}
+
+type TermResponse struct {
+	Id        string             `json:"id"`
+	DeckId    string             `json:"deck_id"`
+	Front     string             `json:"front"`
+	Back      string             `json:"back"`
+	Examples  []string           `json:"examples"`
+	Tags      []string           `json:"tags"`
+	Media     []MediaRefResponse `json:"media"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type DeckResponse struct {
+	Id          string          `json:"id"`
+	OwnerId     string          `json:"owner_id"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Terms       []*TermResponse `json:"terms"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type DeckSummaryResponse struct {
+	Id          string    `json:"id"`
+	OwnerId     string    `json:"owner_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	TermCount   int       `json:"term_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListDecksResponse struct {
+	Decks []*DeckSummaryResponse `json:"decks"`
+	Total int                    `json:"total"`
+}
+
+func NewTermResponse(term *deck.Term) *TermResponse {
+	media := make([]MediaRefResponse, len(term.Media))
+	for i, m := range term.Media {
+		media[i] = MediaRefResponse{Kind: string(m.Kind), URL: m.URL}
+	}
+
+	return &TermResponse{
+		Id:        term.ID.String(),
+		DeckId:    term.DeckID.String(),
+		Front:     term.Front,
+		Back:      term.Back,
+		Examples:  term.Examples,
+		Tags:      term.Tags,
+		Media:     media,
+		CreatedAt: term.CreatedAt,
+		UpdatedAt: term.UpdatedAt,
+	}
+}
+
+func NewDeckResponse(d *deck.Deck) *DeckResponse {
+	terms := make([]*TermResponse, len(d.Terms))
+	for i, term := range d.Terms {
+		terms[i] = NewTermResponse(term)
+	}
+
+	return &DeckResponse{
+		Id:          d.ID.String(),
+		OwnerId:     d.OwnerID.String(),
+		Title:       d.Title,
+		Description: d.Description,
+		Terms:       terms,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func NewDecksResponse(page *deck.Page) *ListDecksResponse {
+	decks := make([]*DeckSummaryResponse, len(page.Decks))
+	for i, s := range page.Decks {
+		decks[i] = &DeckSummaryResponse{
+			Id:          s.ID.String(),
+			OwnerId:     s.OwnerID.String(),
+			Title:       s.Title,
+			Description: s.Description,
+			TermCount:   s.TermCount,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   s.UpdatedAt,
+		}
+	}
+
+	return &ListDecksResponse{
+		Decks: decks,
+		Total: page.Total,
+	}
+}
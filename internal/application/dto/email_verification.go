@@ -0,0 +1,9 @@
+package dto
+
+type VerifyEmailRequest struct {
+	Token string `validate:"required" json:"token"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `validate:"required,email" json:"email"`
+}
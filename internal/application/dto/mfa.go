@@ -0,0 +1,28 @@
+package dto
+
+import "trainer/internal/domain/user"
+
+type StartTOTPRequest struct {
+	UserId string `validate:"required" json:"-"`
+}
+
+type ConfirmTOTPRequest struct {
+	Actor  user.Actor `json:"-"`
+	UserId string     `validate:"required" json:"-"`
+	Code   string     `validate:"required" json:"code"`
+}
+
+type DisableTOTPRequest struct {
+	Actor  user.Actor `json:"-"`
+	UserId string     `validate:"required" json:"-"`
+	Code   string     `validate:"required" json:"code"`
+}
+
+type TOTPEnrollmentResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
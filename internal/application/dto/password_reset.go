@@ -0,0 +1,12 @@
+package dto
+
+type RequestPasswordResetRequest struct {
+	Email string `validate:"required,email" json:"email"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token     string `validate:"required" json:"token"`
+	Password  string `validate:"required" json:"password"`
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
+}
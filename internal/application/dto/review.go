@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/review"
+	"trainer/internal/domain/user"
+)
+
+// DueReviewsRequest is read from query parameters. Terms never reviewed are
+// only added for a deck, up to NewLimit, after the due ones.
+type DueReviewsRequest struct {
+	Actor    user.Actor `json:"-"`
+	DeckId   string     `validate:"required_with=NewLimit,omitempty,uuid" json:"deck_id"`
+	Limit    int        `validate:"omitempty,min=1,max=100" json:"limit"`
+	NewLimit int        `validate:"omitempty,min=1,max=100" json:"new_limit"`
+}
+
+type SubmitReviewRequest struct {
+	Actor      user.Actor `json:"-"`
+	TermId     string     `validate:"required,uuid" json:"term_id"`
+	Grade      int        `validate:"required,min=1,max=4" json:"grade"`
+	DurationMs int64      `validate:"min=0,max=3600000" json:"duration_ms"`
+}
+
+type ReviewStateResponse struct {
+	TermId         string     `json:"term_id"`
+	DeckId         string     `json:"deck_id"`
+	DueAt          time.Time  `json:"due_at"`
+	IntervalDays   int        `json:"interval_days"`
+	Ease           float64    `json:"ease"`
+	Stability      float64    `json:"stability"`
+	Difficulty     float64    `json:"difficulty"`
+	Reps           int        `json:"reps"`
+	Lapses         int        `json:"lapses"`
+	LastReviewedAt *time.Time `json:"last_reviewed_at"`
+}
+
+type DueReviewResponse struct {
+	Term *TermResponse `json:"term"`
+	// State is null for a term the student has never reviewed.
+	State *ReviewStateResponse `json:"state"`
+}
+
+type DueReviewsResponse struct {
+	Reviews []*DueReviewResponse `json:"reviews"`
+}
+
+func NewReviewStateResponse(s *review.State) *ReviewStateResponse {
+	return &ReviewStateResponse{
+		TermId:         s.TermID.String(),
+		DeckId:         s.DeckID.String(),
+		DueAt:          s.DueAt,
+		IntervalDays:   s.IntervalDays,
+		Ease:           s.Ease,
+		Stability:      s.Stability,
+		Difficulty:     s.Difficulty,
+		Reps:           s.Reps,
+		Lapses:         s.Lapses,
+		LastReviewedAt: s.LastReviewedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+	"trainer/internal/domain/user"
+)
+
+type ChangeRoleRequest struct {
+	Actor  user.Actor `json:"-"`
+	Id     string     `validate:"required" json:"-"`
+	Role   string     `validate:"required,oneof=student mentor admin" json:"role"`
+	Reason string     `validate:"max=500" json:"reason"`
+}
+
+type ListRoleChangesRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"-"`
+}
+
+type UserRoleResponse struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type RoleChangeResponse struct {
+	ID        string    `json:"id"`
+	ActorID   string    `json:"actor_id"`
+	OldRole   string    `json:"old_role"`
+	NewRole   string    `json:"new_role"`
+	Reason    string    `json:"reason"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type ListRoleChangesResponse struct {
+	Changes []*RoleChangeResponse `json:"changes"`
+}
+
+func NewUserRoleResponse(u *user.User) *UserRoleResponse {
+	return &UserRoleResponse{
+		UserID: u.ID.String(),
+		Role:   string(u.Role),
+	}
+}
+
+func NewRoleChangesResponse(changes []*user.RoleChange) *ListRoleChangesResponse {
+	resp := make([]*RoleChangeResponse, len(changes))
+	for i, c := range changes {
+		resp[i] = &RoleChangeResponse{
+			ID:        c.ID.String(),
+			ActorID:   c.ActorID.String(),
+			OldRole:   string(c.OldRole),
+			NewRole:   string(c.NewRole),
+			Reason:    c.Reason,
+			ChangedAt: c.ChangedAt,
+		}
+	}
+
+	return &ListRoleChangesResponse{
+		Changes: resp,
+	}
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"html"
+	"strings"
+	"trainer/internal/domain/user"
+)
+
+type SearchUsersRequest struct {
+	Actor user.Actor `json:"-"`
+	Query string     `validate:"required,min=2,max=100" json:"q"`
+	Role  string     `validate:"omitempty,oneof=student mentor admin" json:"role"`
+	Limit int        `validate:"omitempty,min=1,max=50" json:"limit"`
+}
+
+// UserHighlight repeats the searchable fields as HTML-escaped text with the
+// matched words wrapped in <mark>.
+type UserHighlight struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+type UserSearchResult struct {
+	User      *UserResponse  `json:"user"`
+	Score     float64        `json:"score"`
+	Highlight *UserHighlight `json:"highlight"`
+}
+
+type SearchUsersResponse struct {
+	Results []*UserSearchResult `json:"results"`
+}
+
+func NewSearchUsersResponse(results []*user.SearchResult, query string) *SearchUsersResponse {
+	resp := make([]*UserSearchResult, len(results))
+	for i, r := range results {
+		resp[i] = &UserSearchResult{
+			User:  NewUserResponse(r.User),
+			Score: r.Score,
+			Highlight: &UserHighlight{
+				FirstName: highlight(r.User.FirstName, query),
+				LastName:  highlight(r.User.LastName, query),
+				Email:     highlight(r.User.Email, query),
+			},
+		}
+	}
+
+	return &SearchUsersResponse{
+		Results: resp,
+	}
+}
+
+func highlight(text, query string) string {
+	var b strings.Builder
+	pos := 0
+	for _, m := range user.MatchWords(text, query) {
+		b.WriteString(html.EscapeString(text[pos:m.Start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[m.Start:m.End]))
+		b.WriteString("</mark>")
+		pos = m.End
+	}
+	b.WriteString(html.EscapeString(text[pos:]))
+
+	return b.String()
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text, query, want string
+	}{
+		{"Ann Lee", "an", "<mark>Ann</mark> Lee"},
+		{"ann@example.com", "exampel", "ann@<mark>example</mark>.com"},
+		{"O'Brien", "brien", "O&#39;<mark>Brien</mark>"},
+		{"<b>Ann</b>", "ann", "&lt;b&gt;<mark>Ann</mark>&lt;/b&gt;"},
+		{"Bob & Ray", "ann", "Bob &amp; Ray"},
+		{"", "ann", ""},
+	}
+
+	for _, tt := range tests {
+		if got := highlight(tt.text, tt.query); got != tt.want {
+			t.Errorf("highlight(%q, %q) = %q, want %q", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"sort"
+	"time"
+	"trainer/internal/domain/user"
+)
+
+type ListSessionsRequest struct {
+	UserId string `validate:"required" json:"-"`
+}
+
+type RevokeSessionRequest struct {
+	UserId    string `validate:"required" json:"-"`
+	SessionId string `validate:"required" json:"-"`
+}
+
+type SessionResponse struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []*SessionResponse `json:"sessions"`
+}
+
+func NewSessionResponse(token *user.RefreshToken) *SessionResponse {
+	return &SessionResponse{
+		ID:        token.FamilyID.String(),
+		UserAgent: token.UserAgent,
+		IP:        token.IP,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
+
+func NewSessionsResponse(tokens []*user.RefreshToken) *ListSessionsResponse {
+	sessions := make([]*SessionResponse, len(tokens))
+	for i, token := range tokens {
+		sessions[i] = NewSessionResponse(token)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+
+	return &ListSessionsResponse{
+		Sessions: sessions,
+	}
+}
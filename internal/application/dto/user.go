@@ -2,58 +2,119 @@ package dto
 
 import "trainer/internal/domain/user"
 
+// CreateUserRequest is self-registration; new accounts are always students
+// and only an admin can change the role afterwards.
 type CreateUserRequest struct {
-	Role      string `validate:"required" json:"role"`
 	Email     string `validate:"required,email" json:"email"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
-	Password  string `validate:"required"`
+	Password  string `validate:"required" json:"password"`
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
 }
 
 type UpdateUserRequest struct {
-	Id        string `validate:"required" json:"id"`
-	Email     string `validate:"email" json:"email"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Password  string `json:"password"`
+	Actor     user.Actor `json:"-"`
+	Id        string     `validate:"required" json:"id"`
+	Email     string     `validate:"omitempty,email" json:"email"`
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	Password  string     `json:"password"`
+	// IfMatch lists the versions the client edited; empty means unconditional.
+	IfMatch []int `json:"-"`
 }
 
 type GetUserRequest struct {
-	Id string `validate:"required" json:"id"`
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"id"`
 }
 
+// ListUserRequest is read from query parameters. Cursor pages forward from a
+// previous response's next_cursor; without it Page selects an offset page.
+// Mentors only see the students in their cohorts.
 type ListUserRequest struct {
+	Actor       user.Actor `json:"-"`
+	CohortId    string     `validate:"omitempty,uuid" json:"cohort_id"`
+	Cursor      string     `json:"cursor"`
+	Page        int        `validate:"omitempty,min=1" json:"page"`
+	Limit       int        `validate:"omitempty,min=1,max=100" json:"limit"`
+	Role        string     `validate:"omitempty,oneof=student mentor admin" json:"role"`
+	Search      string     `validate:"max=100" json:"search"`
+	CreatedFrom string     `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" json:"created_from"`
+	CreatedTo   string     `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" json:"created_to"`
+	Sort        string     `validate:"omitempty,oneof=created_at -created_at email -email last_name -last_name" json:"sort"`
 }
 
 type DeleteUserRequest struct {
-	Id string `validate:"required" json:"id"`
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"id"`
+	// Hard erases the account instead of soft-deleting it.
+	Hard bool `json:"hard"`
+}
+
+type RestoreUserRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"id"`
+}
+
+type UnlockUserRequest struct {
+	Actor user.Actor `json:"-"`
+	Id    string     `validate:"required" json:"id"`
 }
 
 type UserResponse struct {
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Email     string `json:"email"`
+	Id            string `json:"id"`
+	Role          string `json:"role"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	DisplayName   string `json:"display_name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	PendingEmail  string `json:"pending_email,omitempty"`
+	AvatarURL     string `json:"avatar_url,omitempty"`
+	Locale        string `json:"locale"`
+	Timezone      string `json:"timezone"`
+	Bio           string `json:"bio"`
+	Version       int    `json:"version"`
 }
 
 type ListUserResponse struct {
-	Users []*UserResponse `json:"users"`
+	Users      []*UserResponse `json:"users"`
+	Total      int             `json:"total"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
 
 func NewUserResponse(user *user.User) *UserResponse {
 	return &UserResponse{
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Email:     user.Email,
+		Id:            user.ID.String(),
+		Role:          string(user.Role),
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		DisplayName:   user.Profile.DisplayName,
+		Email:         user.Email,
+		EmailVerified: user.IsEmailVerified(),
+		PendingEmail:  user.PendingEmail,
+		AvatarURL:     user.Profile.AvatarURL,
+		Locale:        string(user.Profile.Locale),
+		Timezone:      user.Profile.Timezone,
+		Bio:           user.Profile.Bio,
+		Version:       user.Version,
 	}
 }
 
-func NewUsersResponse(users []*user.User) *ListUserResponse {
-	responseUsers := make([]*UserResponse, len(users))
-	for i, userModel := range users {
+func NewUsersResponse(page *user.UserPage) *ListUserResponse {
+	responseUsers := make([]*UserResponse, len(page.Users))
+	for i, userModel := range page.Users {
 		responseUsers[i] = NewUserResponse(userModel)
 	}
 
-	return &ListUserResponse{
+	resp := &ListUserResponse{
 		Users: responseUsers,
+		Total: page.Total,
 	}
+	if page.Next != nil {
+		resp.NextCursor = page.Next.Encode()
+	}
+
+	return resp
 }
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"io"
+	"time"
+	"trainer/internal/domain/user"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// ImportUserRow is one account of an import file. CSV files carry the JSON
+// names in their header row; role defaults to student.
+type ImportUserRow struct {
+	Email     string `validate:"required,email" json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Password  string `validate:"required" json:"password"`
+	Role      string `validate:"omitempty,oneof=admin mentor student" json:"role"`
+}
+
+type ImportUsersRequest struct {
+	Actor  user.Actor `json:"-"`
+	Format string     `validate:"required,oneof=csv json" json:"format"`
+	// DryRun validates the file without creating anyone.
+	DryRun bool      `json:"dry_run"`
+	Data   io.Reader `validate:"required" json:"-"`
+}
+
+// ImportRowError reports why a row was rejected. Row counts data rows from 1,
+// not counting the CSV header.
+type ImportRowError struct {
+	Row    int              `json:"row"`
+	Email  string           `json:"email,omitempty"`
+	Code   string           `json:"code"`
+	Fields []ImportFieldErr `json:"fields,omitempty"`
+}
+
+type ImportFieldErr struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+}
+
+// ImportUsersResponse is the import report. Nothing is imported unless every
+// row is valid.
+type ImportUsersResponse struct {
+	Total    int              `json:"total"`
+	Valid    int              `json:"valid"`
+	Imported int              `json:"imported"`
+	DryRun   bool             `json:"dry_run"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+type ExportUsersRequest struct {
+	Actor  user.Actor `json:"-"`
+	Format string     `validate:"required,oneof=csv json" json:"format"`
+	Role   string     `validate:"omitempty,oneof=admin mentor student" json:"role"`
+}
+
+// ExportUserRow uses the import column names, so an export can be edited
+// and imported elsewhere once passwords are filled in.
+type ExportUserRow struct {
+	ID            string    `json:"id"`
+	Email         string    `json:"email"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewExportUserRow(u *user.User) ExportUserRow {
+	return ExportUserRow{
+		ID:            u.ID.String(),
+		Email:         u.Email,
+		FirstName:     u.FirstName,
+		LastName:      u.LastName,
+		Role:          string(u.Role),
+		EmailVerified: u.IsEmailVerified(),
+		CreatedAt:     u.CreatedAt,
+	}
+}
@@ -1,8 +1,33 @@
 package application
 
-import "github.com/go-playground/validator/v10"
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CodeValidationFailed is the error code reported for a DTO that fails
+// ValidateDTO.
+const CodeValidationFailed = "VALIDATION_FAILED"
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	// Report JSON field names so validation errors match the request payload.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	return v
+}
 
 func ValidateDTO(dto interface{}) error {
-	validate := validator.New()
 	return validate.Struct(dto)
 }
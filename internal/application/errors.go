@@ -0,0 +1,8 @@
+package application
+
+import "errors"
+
+var (
+	ErrInvalidImport  = errors.New("INVALID_IMPORT")
+	ErrImportTooLarge = errors.New("IMPORT_TOO_LARGE")
+)
@@ -0,0 +1,15 @@
+package application
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage keeps user uploads such as avatars under slash-separated keys.
+type FileStorage interface {
+	// Save stores content under key and returns the URL clients load it from.
+	Save(ctx context.Context, key string, content io.Reader) (string, error)
+
+	// Delete removes the file; a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
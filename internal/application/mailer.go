@@ -0,0 +1,13 @@
+package application
+
+import "context"
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
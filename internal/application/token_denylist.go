@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenDenylist rejects access tokens before their exp. A token is revoked
+// when its session or its user was revoked at or after the token was issued.
+type TokenDenylist interface {
+	RevokeSession(ctx context.Context, sessionID uuid.UUID) error
+	RevokeUser(ctx context.Context, userID uuid.UUID) error
+	IsRevoked(ctx context.Context, claim *TokenClaim) (bool, error)
+}
+
+// RevokedAccess is a denylist entry. Key is "sid:<session>" or "sub:<user>";
+// the entry is only needed until ExpiresAt, when every token it covers has
+// expired on its own.
+type RevokedAccess struct {
+	Key       string
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+type RevokedAccessRepository interface {
+	Save(ctx context.Context, entry *RevokedAccess) error
+	FindActive(ctx context.Context, now time.Time) ([]*RevokedAccess, error)
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
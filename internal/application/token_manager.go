@@ -1,6 +1,7 @@
 package application
 
 import (
+	"time"
 	"trainer/internal/domain/user"
 
 	"github.com/google/uuid"
@@ -12,6 +13,33 @@ type TokenManager interface {
 }
 
 type TokenClaim struct {
+	// ID and IssuedAt are set on parsed tokens; Generate assigns them.
+	ID     string
 	UserID uuid.UUID
 	Role   user.Role
+	// SessionID is the refresh token family the access token was issued for.
+	SessionID uuid.UUID
+	IssuedAt  time.Time
+}
+
+// KeySet publishes the public keys other services can verify access tokens with.
+type KeySet interface {
+	JWKS() JWKS
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a public key in RFC 7517 form. N and E are set for RSA keys,
+// Crv and X for Ed25519 keys.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
 }
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+)
+
+type AddTerm struct {
+	deckRepository deck.Repository
+}
+
+func NewAddTerm(deckRepository deck.Repository) *AddTerm {
+	return &AddTerm{
+		deckRepository: deckRepository,
+	}
+}
+
+// Execute appends a term to the end of the deck.
+func (u *AddTerm) Execute(ctx context.Context, req dto.AddTermRequest) (*dto.TermResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.DeckId)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := deckModel.AddTerm(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.deckRepository.Update(ctx, deckModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewTermResponse(term), nil
+}
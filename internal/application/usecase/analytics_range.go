@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"time"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAnalyticsDays = 30
+	hardestTermsLimit    = 10
+)
+
+// newAnalyticsRange reads the filter's dates in its time zone and also
+// returns today there. Without dates the range is the 30 days to today.
+func newAnalyticsRange(f dto.AnalyticsFilter, now time.Time) (analytics.Range, time.Time, error) {
+	// An empty name loads UTC; Local would depend on the server.
+	loc, err := time.LoadLocation(f.Timezone)
+	if err != nil || f.Timezone == "Local" {
+		return analytics.Range{}, time.Time{}, analytics.ErrInvalidTimezone
+	}
+
+	today := now.In(loc)
+
+	// The validator has already checked the format.
+	last := today
+	if f.To != "" {
+		last, _ = time.ParseInLocation(time.DateOnly, f.To, loc)
+	}
+	first := last.AddDate(0, 0, 1-defaultAnalyticsDays)
+	if f.From != "" {
+		first, _ = time.ParseInLocation(time.DateOnly, f.From, loc)
+	}
+
+	r, err := analytics.NewRange(first, last, loc)
+	if err != nil {
+		return analytics.Range{}, time.Time{}, err
+	}
+
+	return r, today, nil
+}
+
+// hardestTermsOwner returns the deck owner the hardest terms shown to actor
+// are limited to: mentors only see terms of their own decks, while admins and
+// students reading their own report see every deck.
+func hardestTermsOwner(actor user.Actor, self bool) uuid.UUID {
+	if self || actor.Role.Can(user.PermissionCoursesAdmin) {
+		return uuid.Nil
+	}
+	return actor.ID
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// findManagedAssignment loads an assignment of a cohort the actor may
+// manage.
+func findManagedAssignment(ctx context.Context, repo assignment.Repository, cohorts cohort.Repository, actor user.Actor, id string) (*assignment.Assignment, error) {
+	assignmentId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, assignment.ErrAssignmentNotFound
+	}
+
+	assignmentModel, err := repo.FindByID(ctx, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if assignmentModel == nil {
+		return nil, assignment.ErrAssignmentNotFound
+	}
+
+	if _, err := findManagedCohort(ctx, cohorts, actor, assignmentModel.CohortID.String()); err != nil {
+		return nil, err
+	}
+
+	return assignmentModel, nil
+}
+
+// assignedDecks lists the decks the actor may study: those assigned to the
+// cohorts they are a member of.
+func assignedDecks(ctx context.Context, repo assignment.Repository, actor user.Actor) ([]uuid.UUID, error) {
+	// A zero ID matches no membership, but say so explicitly.
+	if actor.ID == uuid.Nil {
+		return nil, user.ErrAccessDenied
+	}
+
+	return repo.AssignedDecks(ctx, actor.ID)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+func newAuditEvent(action audit.Action, actorID, targetID uuid.UUID, client user.ClientInfo) *audit.Event {
+	return audit.NewEvent(action, actorID, targetID, client.IP, client.UserAgent)
+}
+
+// personalFields are the userSnapshot fields that identify a person. They are
+// redacted from the trail once the user is erased.
+var personalFields = []string{"email", "pending_email", "first_name", "last_name", "display_name"}
+
+// userSnapshot is the audited state of a user. Secrets are left out; a
+// password change is an event of its own.
+func userSnapshot(u *user.User) map[string]any {
+	snapshot := map[string]any{
+		"email":          u.Email,
+		"pending_email":  u.PendingEmail,
+		"first_name":     u.FirstName,
+		"last_name":      u.LastName,
+		"display_name":   u.Profile.DisplayName,
+		"locale":         string(u.Profile.Locale),
+		"timezone":       u.Profile.Timezone,
+		"has_avatar":     u.Profile.AvatarKey != "",
+		"role":           string(u.Role),
+		"email_verified": u.IsEmailVerified(),
+		"mfa_enabled":    u.IsMFAEnabled(),
+		"deleted":        u.IsDeleted(),
+	}
+	return snapshot
+}
+
+// erasedSnapshot is userSnapshot without the personal fields, for the events
+// that outlive the user.
+func erasedSnapshot(u *user.User) map[string]any {
+	snapshot := userSnapshot(u)
+	for _, field := range personalFields {
+		delete(snapshot, field)
+	}
+	return snapshot
+}
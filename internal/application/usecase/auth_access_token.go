@@ -2,53 +2,109 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
 	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
 )
 
 type AccessToken struct {
-	userService    *user.Service
-	userRepository user.Repository
-	jwtManager     application.TokenManager
+	userService         *user.Service
+	userRepository      user.Repository
+	challengeRepository user.MFAChallengeRepository
+	jwtManager          application.TokenManager
+	loginGuard          *user.LoginGuard
+	auditor             application.Auditor
 }
 
-func NewAccessToken(userService *user.Service, userRepository user.Repository, jwtManager application.TokenManager) *AccessToken {
+func NewAccessToken(
+	userService *user.Service,
+	userRepository user.Repository,
+	challengeRepository user.MFAChallengeRepository,
+	jwtManager application.TokenManager,
+	loginGuard *user.LoginGuard,
+	auditor application.Auditor,
+) *AccessToken {
 	return &AccessToken{
-		userService:    userService,
-		userRepository: userRepository,
-		jwtManager:     jwtManager,
+		userService:         userService,
+		userRepository:      userRepository,
+		challengeRepository: challengeRepository,
+		jwtManager:          jwtManager,
+		loginGuard:          loginGuard,
+		auditor:             auditor,
 	}
 }
 
-func (a *AccessToken) Execute(ctx context.Context, req dto.AccessTokenRequest) (*dto.TokenResponse, error) {
+func (a *AccessToken) Execute(ctx context.Context, req dto.AccessTokenRequest) (*dto.LoginResponse, error) {
 	if errValidate := application.ValidateDTO(req); errValidate != nil {
 		return nil, errValidate
 	}
 
+	client := user.ClientInfo{
+		UserAgent: req.UserAgent,
+		IP:        req.IP,
+	}
+
+	if err := a.loginGuard.Check(ctx, req.Email, req.IP); err != nil {
+		if errors.Is(err, user.ErrAccountLocked) || errors.Is(err, user.ErrTooManyAttempts) {
+			a.recordFailure(ctx, req.Email, client, err)
+		}
+		return nil, err
+	}
+
 	loggedUser, err := a.userService.Login(ctx, req.Email, req.Password)
+	if errors.Is(err, user.ErrInvalidCredentials) {
+		if errRecord := a.loginGuard.RecordFailure(ctx, req.Email, req.IP); errRecord != nil {
+			return nil, errRecord
+		}
+		a.recordFailure(ctx, req.Email, client, err)
+		return nil, err
+	}
+	if errors.Is(err, user.ErrEmailNotVerified) {
+		a.recordFailure(ctx, req.Email, client, err)
+		return nil, err
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	accessToken, err := a.jwtManager.Generate(application.TokenClaim{
-		UserID: loggedUser.ID,
-		Role:   loggedUser.Role,
-	})
+	// With MFA the failures are only forgotten once the code is right too.
+	if loggedUser.IsMFAEnabled() {
+		challenge, secret, err := a.userService.NewMFAChallenge(ctx, loggedUser, client)
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
+		if err := a.challengeRepository.Save(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		a.auditor.Record(ctx, newAuditEvent(audit.ActionMFAChallenge, loggedUser.ID, loggedUser.ID, client))
+
+		return dto.NewMFAChallengeResponse(secret, challenge), nil
+	}
+
+	if err := a.loginGuard.RecordSuccess(ctx, req.Email); err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := a.userService.CreateRefreshToken(ctx, loggedUser)
+	tokenResp, err := issueTokens(ctx, a.userService, a.userRepository, a.jwtManager, loggedUser, client)
 	if err != nil {
 		return nil, err
 	}
 
-	errSave := a.userRepository.Update(ctx, loggedUser)
-	if errSave != nil {
-		return nil, errSave
-	}
+	a.auditor.Record(ctx, newAuditEvent(audit.ActionLogin, loggedUser.ID, loggedUser.ID, client))
+
+	return &dto.LoginResponse{TokenResponse: tokenResp}, nil
+}
 
-	return dto.NewTokenResponse(accessToken, refreshToken.ID.String(), loggedUser), nil
+// recordFailure audits a rejected login. The attempt is anonymous: the email
+// may not belong to anyone.
+func (a *AccessToken) recordFailure(ctx context.Context, email string, client user.ClientInfo, reason error) {
+	a.auditor.Record(ctx, newAuditEvent(audit.ActionLoginFailed, uuid.Nil, uuid.Nil, client).
+		WithMetadata("email", email).
+		WithMetadata("reason", reason.Error()))
 }
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type Logout struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+}
+
+func NewLogout(userService *user.Service, userRepository user.Repository, denylist application.TokenDenylist) *Logout {
+	return &Logout{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+	}
+}
+
+func (l *Logout) Execute(ctx context.Context, req dto.LogoutRequest) error {
+	if errValidate := application.ValidateDTO(req); errValidate != nil {
+		return errValidate
+	}
+
+	refreshToken, err := uuid.Parse(req.RefreshToken)
+	if err != nil {
+		return user.ErrInvalidRefreshToken
+	}
+
+	loggedUser, err := l.userRepository.FindByToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if loggedUser == nil {
+		return user.ErrInvalidRefreshToken
+	}
+
+	if err := l.userService.Logout(ctx, loggedUser, refreshToken); err != nil {
+		return err
+	}
+
+	if err := l.userRepository.UpdateSessions(ctx, loggedUser); err != nil {
+		return err
+	}
+
+	return revokeEndedSessions(ctx, l.denylist, loggedUser)
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type LogoutAll struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+}
+
+func NewLogoutAll(userService *user.Service, userRepository user.Repository, denylist application.TokenDenylist) *LogoutAll {
+	return &LogoutAll{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+	}
+}
+
+func (l *LogoutAll) Execute(ctx context.Context, req dto.LogoutAllRequest) error {
+	if errValidate := application.ValidateDTO(req); errValidate != nil {
+		return errValidate
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return user.ErrUserNotFound
+	}
+
+	loggedUser, err := l.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if loggedUser == nil {
+		return user.ErrUserNotFound
+	}
+
+	l.userService.RevokeAllSessions(ctx, loggedUser)
+
+	if err := l.userRepository.UpdateSessions(ctx, loggedUser); err != nil {
+		return err
+	}
+
+	return revokeEndedSessions(ctx, l.denylist, loggedUser)
+}
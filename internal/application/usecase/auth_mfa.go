@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type CompleteMFA struct {
+	userService         *user.Service
+	userRepository      user.Repository
+	challengeRepository user.MFAChallengeRepository
+	jwtManager          application.TokenManager
+	loginGuard          *user.LoginGuard
+	auditor             application.Auditor
+}
+
+func NewCompleteMFA(
+	userService *user.Service,
+	userRepository user.Repository,
+	challengeRepository user.MFAChallengeRepository,
+	jwtManager application.TokenManager,
+	loginGuard *user.LoginGuard,
+	auditor application.Auditor,
+) *CompleteMFA {
+	return &CompleteMFA{
+		userService:         userService,
+		userRepository:      userRepository,
+		challengeRepository: challengeRepository,
+		jwtManager:          jwtManager,
+		loginGuard:          loginGuard,
+		auditor:             auditor,
+	}
+}
+
+func (c *CompleteMFA) Execute(ctx context.Context, req dto.CompleteMFARequest) (*dto.TokenResponse, error) {
+	if errValidate := application.ValidateDTO(req); errValidate != nil {
+		return nil, errValidate
+	}
+
+	challenge, err := c.challengeRepository.FindByHash(ctx, user.HashSecret(req.MFAToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge == nil {
+		return nil, user.ErrInvalidMFAChallenge
+	}
+
+	loggedUser, err := c.userRepository.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if loggedUser == nil {
+		return nil, user.ErrInvalidMFAChallenge
+	}
+
+	client := challenge.ClientInfo()
+
+	// Wrong codes count as failed logins, so a guessed password does not
+	// buy unlimited guesses of the code through new challenges.
+	if err := c.loginGuard.Check(ctx, loggedUser.Email, client.IP); err != nil {
+		return nil, err
+	}
+
+	err = c.userService.CompleteMFAChallenge(ctx, loggedUser, challenge, req.Code)
+	if errors.Is(err, user.ErrInvalidMFACode) {
+		if errSave := c.challengeRepository.AddFailure(ctx, challenge); errSave != nil {
+			return nil, errSave
+		}
+		return nil, c.rejectCode(ctx, loggedUser, client, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.challengeRepository.Consume(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	// Storing the spent code fails if a parallel login spent it first.
+	tokenResp, err := issueTokens(ctx, c.userService, c.userRepository, c.jwtManager, loggedUser, client)
+	if errors.Is(err, user.ErrInvalidMFACode) {
+		return nil, c.rejectCode(ctx, loggedUser, client, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.loginGuard.RecordSuccess(ctx, loggedUser.Email); err != nil {
+		return nil, err
+	}
+
+	c.auditor.Record(ctx, newAuditEvent(audit.ActionLogin, loggedUser.ID, loggedUser.ID, client).
+		WithMetadata("mfa", true))
+
+	return tokenResp, nil
+}
+
+// rejectCode counts a wrong code as a failed login and returns err.
+func (c *CompleteMFA) rejectCode(ctx context.Context, u *user.User, client user.ClientInfo, err error) error {
+	if errRecord := c.loginGuard.RecordFailure(ctx, u.Email, client.IP); errRecord != nil {
+		return errRecord
+	}
+	c.auditor.Record(ctx, newAuditEvent(audit.ActionLoginFailed, u.ID, u.ID, client).
+		WithMetadata("reason", err.Error()))
+	return err
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
 	"trainer/internal/domain/user"
 
 	"github.com/google/uuid"
@@ -13,13 +15,23 @@ type RefreshToken struct {
 	userService    *user.Service
 	userRepository user.Repository
 	jwtManager     application.TokenManager
+	denylist       application.TokenDenylist
+	auditor        application.Auditor
 }
 
-func NewRefreshToken(userService *user.Service, userRepository user.Repository, jwtManager application.TokenManager) *RefreshToken {
+func NewRefreshToken(
+	userService *user.Service,
+	userRepository user.Repository,
+	jwtManager application.TokenManager,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *RefreshToken {
 	return &RefreshToken{
 		userService:    userService,
 		userRepository: userRepository,
 		jwtManager:     jwtManager,
+		denylist:       denylist,
+		auditor:        auditor,
 	}
 }
 
@@ -30,7 +42,7 @@ func (r *RefreshToken) Execute(ctx context.Context, req dto.RefreshTokenRequest)
 
 	refreshToken, err := uuid.Parse(req.RefreshToken)
 	if err != nil {
-		return nil, err
+		return nil, user.ErrInvalidRefreshToken
 	}
 
 	loggedUser, err := r.userRepository.FindByToken(ctx, refreshToken)
@@ -38,25 +50,53 @@ func (r *RefreshToken) Execute(ctx context.Context, req dto.RefreshTokenRequest)
 		return nil, err
 	}
 
-	newToken, err := r.userService.RenewRefreshToken(ctx, loggedUser, refreshToken)
+	if loggedUser == nil {
+		return nil, user.ErrInvalidRefreshToken
+	}
+
+	client := user.ClientInfo{
+		UserAgent: req.UserAgent,
+		IP:        req.IP,
+	}
+
+	newToken, err := r.userService.RenewRefreshToken(ctx, loggedUser, refreshToken, client)
+
+	if errors.Is(err, user.ErrRefreshTokenReused) {
+		if errSave := r.userRepository.UpdateSessions(ctx, loggedUser); errSave != nil {
+			return nil, errSave
+		}
+		if errRevoke := revokeEndedSessions(ctx, r.denylist, loggedUser); errRevoke != nil {
+			return nil, errRevoke
+		}
+		r.auditor.Record(ctx, newAuditEvent(audit.ActionTokenReuse, uuid.Nil, loggedUser.ID, client).
+			WithMetadata("sessions", loggedUser.EndedSessions()))
+		return nil, err
+	}
 
 	if newToken == nil || err != nil {
 		return nil, err
 	}
 
-	errSave := r.userRepository.Update(ctx, loggedUser)
+	errSave := r.userRepository.UpdateSessions(ctx, loggedUser)
 	if errSave != nil {
 		return nil, errSave
 	}
 
 	accessToken, err := r.jwtManager.Generate(application.TokenClaim{
-		UserID: loggedUser.ID,
-		Role:   loggedUser.Role,
+		UserID:    loggedUser.ID,
+		Role:      r.userService.ClaimRole(loggedUser),
+		SessionID: newToken.FamilyID,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return dto.NewTokenResponse(accessToken, newToken.ID.String(), loggedUser), nil
+	r.auditor.Record(ctx, newAuditEvent(audit.ActionTokenRefresh, loggedUser.ID, loggedUser.ID, client).
+		WithMetadata("session", newToken.FamilyID))
+
+	resp := dto.NewTokenResponse(accessToken, newToken.ID.String(), loggedUser)
+	resp.MFAEnrollmentRequired = r.userService.MFAEnrollmentRequired(loggedUser)
+
+	return resp, nil
 }
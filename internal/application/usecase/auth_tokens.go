@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+)
+
+// issueTokens starts a new session for a user who passed every login step.
+func issueTokens(
+	ctx context.Context,
+	userService *user.Service,
+	userRepository user.Repository,
+	jwtManager application.TokenManager,
+	loggedUser *user.User,
+	client user.ClientInfo,
+) (*dto.TokenResponse, error) {
+	refreshToken, err := userService.CreateRefreshToken(ctx, loggedUser, client)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwtManager.Generate(application.TokenClaim{
+		UserID:    loggedUser.ID,
+		Role:      userService.ClaimRole(loggedUser),
+		SessionID: refreshToken.FamilyID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	errSave := userRepository.UpdateSessions(ctx, loggedUser)
+	if errSave != nil {
+		return nil, errSave
+	}
+
+	resp := dto.NewTokenResponse(accessToken, refreshToken.ID.String(), loggedUser)
+	resp.MFAEnrollmentRequired = userService.MFAEnrollmentRequired(loggedUser)
+
+	return resp, nil
+}
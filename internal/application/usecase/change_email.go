@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type ChangeEmail struct {
+	userService        *user.Service
+	userRepository     user.Repository
+	verificationMailer *VerificationMailer
+	mailer             application.Mailer
+	auditor            application.Auditor
+}
+
+func NewChangeEmail(
+	userService *user.Service,
+	userRepository user.Repository,
+	verificationMailer *VerificationMailer,
+	mailer application.Mailer,
+	auditor application.Auditor,
+) *ChangeEmail {
+	return &ChangeEmail{
+		userService:        userService,
+		userRepository:     userRepository,
+		verificationMailer: verificationMailer,
+		mailer:             mailer,
+		auditor:            auditor,
+	}
+}
+
+// Execute asks to move the caller to a new address. The current address stays
+// in use until the link sent to the new one is opened, and is told about the
+// change in case the caller is not its owner.
+func (u *ChangeEmail) Execute(ctx context.Context, req dto.ChangeEmailRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	before := userSnapshot(userModel)
+
+	if err := u.userService.ChangeEmail(ctx, userModel, req.Email, req.Password); err != nil {
+		return nil, err
+	}
+
+	event := newAuditEvent(audit.ActionUserUpdate, userModel.ID, userModel.ID, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel))
+	if !event.HasChanges() {
+		return dto.NewUserResponse(userModel), nil
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, event)
+
+	if userModel.PendingEmail == "" {
+		return dto.NewUserResponse(userModel), nil
+	}
+
+	// As on registration, a failed delivery can be retried through the
+	// resend endpoint.
+	if err := u.verificationMailer.Send(ctx, userModel); err != nil {
+		log.Printf("send verification email to %s: %v", userModel.PendingEmail, err)
+	}
+
+	notice := application.Message{
+		To:      userModel.Email,
+		Subject: "Your email is being changed",
+		Body: fmt.Sprintf(
+			"A change of your account email to %s was requested. Your current address stays in use until the new one is confirmed.\n\nIf you did not ask for this, change your password.",
+			userModel.PendingEmail,
+		),
+	}
+	if err := u.mailer.Send(ctx, notice); err != nil {
+		log.Printf("send email change notice to %s: %v", userModel.Email, err)
+	}
+
+	return dto.NewUserResponse(userModel), nil
+}
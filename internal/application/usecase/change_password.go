@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type ChangePassword struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+	auditor        application.Auditor
+}
+
+func NewChangePassword(
+	userService *user.Service,
+	userRepository user.Repository,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *ChangePassword {
+	return &ChangePassword{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+		auditor:        auditor,
+	}
+}
+
+// Execute changes the password of the caller and ends every other session,
+// so a stolen session cannot outlive the change.
+func (u *ChangePassword) Execute(ctx context.Context, req dto.ChangePasswordRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return err
+	}
+
+	err = u.userService.ChangePassword(ctx, userModel, req.CurrentPassword, req.NewPassword, req.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionPasswordChange, userModel.ID, userModel.ID, req.Actor.Client).
+		WithMetadata("sessions_revoked", len(userModel.EndedSessions())))
+
+	return revokeEndedSessions(ctx, u.denylist, userModel)
+}
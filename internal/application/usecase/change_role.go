@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ChangeRole struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+	auditor        application.Auditor
+}
+
+func NewChangeRole(
+	userService *user.Service,
+	userRepository user.Repository,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *ChangeRole {
+	return &ChangeRole{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+		auditor:        auditor,
+	}
+}
+
+func (u *ChangeRole) Execute(ctx context.Context, req dto.ChangeRoleRequest) (*dto.UserRoleResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	change, err := u.userService.ChangeRole(ctx, req.Actor, userModel, user.Role(req.Role), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if change == nil {
+		return dto.NewUserRoleResponse(userModel), nil
+	}
+
+	// The role history is written in the same transaction.
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionRoleChange, req.Actor.ID, userModel.ID, req.Actor.Client).
+		SetChanges(map[string]any{"role": string(change.OldRole)}, map[string]any{"role": string(change.NewRole)}).
+		WithMetadata("reason", change.Reason))
+
+	// Access tokens carry the role, so the old ones must not outlive it.
+	if err := u.denylist.RevokeUser(ctx, userModel.ID); err != nil {
+		return nil, err
+	}
+
+	return dto.NewUserRoleResponse(userModel), nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// joinCodeAttempts bounds the retries when a new join code happens to be
+// taken by another cohort.
+const joinCodeAttempts = 3
+
+// findManagedCohort loads a cohort the actor may manage.
+func findManagedCohort(ctx context.Context, repo cohort.Repository, actor user.Actor, id string) (*cohort.Cohort, error) {
+	cohortId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, cohort.ErrCohortNotFound
+	}
+
+	cohortModel, err := repo.FindByID(ctx, cohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	if cohortModel == nil {
+		return nil, cohort.ErrCohortNotFound
+	}
+
+	if err := cohortModel.AuthorizeManage(actor); err != nil {
+		return nil, err
+	}
+
+	return cohortModel, nil
+}
+
+// storeWithFreshCode runs store, rotating the join code of c and trying
+// again when the code is already taken.
+func storeWithFreshCode(c *cohort.Cohort, store func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := store()
+		if !errors.Is(err, cohort.ErrJoinCodeTaken) || attempt == joinCodeAttempts {
+			return err
+		}
+
+		if err := c.RotateJoinCode(); err != nil {
+			return err
+		}
+	}
+}
+
+// readableUserIDs returns the users the actor may read besides themselves:
+// nil for everyone, otherwise the students in the actor's cohorts.
+func readableUserIDs(ctx context.Context, repo cohort.Repository, actor user.Actor) ([]uuid.UUID, error) {
+	if err := actor.Authorize(user.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	if actor.Role.Can(user.PermissionUsersReadAll) {
+		return nil, nil
+	}
+
+	return repo.StudentIDs(ctx, actor.ID)
+}
+
+// authorizeUserRead lets every user read their own account, mentors their
+// students' and admins anyone's.
+func authorizeUserRead(ctx context.Context, repo cohort.Repository, actor user.Actor, target uuid.UUID) error {
+	if actor.ID == target {
+		return nil
+	}
+
+	if err := actor.Authorize(user.PermissionUsersRead); err != nil {
+		return err
+	}
+
+	if actor.Role.Can(user.PermissionUsersReadAll) {
+		return nil
+	}
+
+	found, err := repo.HasStudent(ctx, actor.ID, target)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return user.ErrAccessDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type ConfirmPasswordReset struct {
+	userService     *user.Service
+	userRepository  user.Repository
+	resetRepository user.PasswordResetRepository
+	denylist        application.TokenDenylist
+	auditor         application.Auditor
+}
+
+func NewConfirmPasswordReset(
+	userService *user.Service,
+	userRepository user.Repository,
+	resetRepository user.PasswordResetRepository,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *ConfirmPasswordReset {
+	return &ConfirmPasswordReset{
+		userService:     userService,
+		userRepository:  userRepository,
+		resetRepository: resetRepository,
+		denylist:        denylist,
+		auditor:         auditor,
+	}
+}
+
+func (u *ConfirmPasswordReset) Execute(ctx context.Context, req dto.ConfirmPasswordResetRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	token, err := u.resetRepository.FindByHash(ctx, user.HashSecret(req.Token))
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return user.ErrInvalidResetToken
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return user.ErrInvalidResetToken
+	}
+
+	err = u.userService.ResetPassword(ctx, userModel, token, req.Password)
+	if err != nil {
+		return err
+	}
+
+	// Consume first: a failed update then costs the user a new request
+	// rather than leaving a reusable token behind.
+	if err := u.resetRepository.Consume(ctx, token); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return err
+	}
+
+	client := user.ClientInfo{UserAgent: req.UserAgent, IP: req.IP}
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionPasswordReset, userModel.ID, userModel.ID, client))
+
+	return revokeEndedSessions(ctx, u.denylist, userModel)
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ConfirmTOTP struct {
+	userService    *user.Service
+	userRepository user.Repository
+	auditor        application.Auditor
+}
+
+func NewConfirmTOTP(userService *user.Service, userRepository user.Repository, auditor application.Auditor) *ConfirmTOTP {
+	return &ConfirmTOTP{
+		userService:    userService,
+		userRepository: userRepository,
+		auditor:        auditor,
+	}
+}
+
+func (u *ConfirmTOTP) Execute(ctx context.Context, req dto.ConfirmTOTPRequest) (*dto.RecoveryCodesResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	codes, err := u.userService.ConfirmTOTPEnrollment(ctx, userModel, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionMFAEnable, req.Actor.ID, userModel.ID, req.Actor.Client))
+
+	return &dto.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/deck"
+)
+
+type CreateAssignment struct {
+	assignmentRepository assignment.Repository
+	cohortRepository     cohort.Repository
+	deckRepository       deck.Repository
+}
+
+func NewCreateAssignment(assignmentRepository assignment.Repository, cohortRepository cohort.Repository, deckRepository deck.Repository) *CreateAssignment {
+	return &CreateAssignment{
+		assignmentRepository: assignmentRepository,
+		cohortRepository:     cohortRepository,
+		deckRepository:       deckRepository,
+	}
+}
+
+// Execute assigns a deck the actor manages to a cohort they manage.
+func (u *CreateAssignment) Execute(ctx context.Context, req dto.CreateAssignmentRequest) (*dto.AssignmentResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.DeckId)
+	if err != nil {
+		return nil, err
+	}
+
+	title := req.Title
+	if strings.TrimSpace(title) == "" {
+		title = deckModel.Title
+	}
+
+	// The validator has already checked the format.
+	dueAt, _ := time.Parse(time.RFC3339, req.DueAt)
+
+	assignmentModel, err := assignment.NewAssignment(cohortModel.ID, deckModel.ID, title, dueAt, req.TargetPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.assignmentRepository.Save(ctx, assignmentModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewAssignmentResponse(assignmentModel), nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+)
+
+type CreateCohort struct {
+	cohortRepository cohort.Repository
+}
+
+func NewCreateCohort(cohortRepository cohort.Repository) *CreateCohort {
+	return &CreateCohort{
+		cohortRepository: cohortRepository,
+	}
+}
+
+// Execute creates a cohort led by the actor.
+func (u *CreateCohort) Execute(ctx context.Context, req dto.CreateCohortRequest) (*dto.CohortResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := cohort.NewCohort(req.Actor.ID, req.Name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = storeWithFreshCode(cohortModel, func() error {
+		return u.cohortRepository.Save(ctx, cohortModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCohortResponse(cohortModel, 0, []*cohort.Member{}), nil
+}
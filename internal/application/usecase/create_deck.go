@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/user"
+)
+
+type CreateDeck struct {
+	deckRepository deck.Repository
+}
+
+func NewCreateDeck(deckRepository deck.Repository) *CreateDeck {
+	return &CreateDeck{
+		deckRepository: deckRepository,
+	}
+}
+
+// Execute creates an empty deck owned by the actor.
+func (u *CreateDeck) Execute(ctx context.Context, req dto.CreateDeckRequest) (*dto.DeckResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return nil, err
+	}
+
+	deckModel, err := deck.NewDeck(req.Actor.ID, req.Title, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.deckRepository.Save(ctx, deckModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewDeckResponse(deckModel), nil
+}
@@ -2,21 +2,31 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"log"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
 	"trainer/internal/domain/user"
 )
 
 type CreateUser struct {
-	userService    *user.Service
-	userRepository user.Repository
+	userService        *user.Service
+	userRepository     user.Repository
+	verificationMailer *VerificationMailer
+	auditor            application.Auditor
 }
 
-func NewCreateUser(userService *user.Service, userRepository user.Repository) *CreateUser {
+func NewCreateUser(
+	userService *user.Service,
+	userRepository user.Repository,
+	verificationMailer *VerificationMailer,
+	auditor application.Auditor,
+) *CreateUser {
 	return &CreateUser{
-		userService:    userService,
-		userRepository: userRepository,
+		userService:        userService,
+		userRepository:     userRepository,
+		verificationMailer: verificationMailer,
+		auditor:            auditor,
 	}
 }
 
@@ -31,10 +41,10 @@ func (u *CreateUser) Execute(ctx context.Context, req dto.CreateUserRequest) (*d
 	}
 
 	if userWithSameEmail != nil {
-		return nil, errors.New("Not unique email")
+		return nil, user.ErrEmailAlreadyUsed
 	}
 
-	createdUser, err := u.userService.NewUser(ctx, req.Email, req.FirstName, req.LastName, req.Password, req.Role)
+	createdUser, err := u.userService.NewUser(ctx, req.Email, req.FirstName, req.LastName, req.Password, user.RoleStudent)
 
 	if err != nil {
 		return nil, err
@@ -46,5 +56,15 @@ func (u *CreateUser) Execute(ctx context.Context, req dto.CreateUserRequest) (*d
 		return nil, err
 	}
 
+	client := user.ClientInfo{UserAgent: req.UserAgent, IP: req.IP}
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserCreate, createdUser.ID, createdUser.ID, client).
+		SetChanges(nil, userSnapshot(createdUser)))
+
+	// The account exists at this point; a failed delivery can be retried
+	// through the resend endpoint, so it is only logged.
+	if err := u.verificationMailer.Send(ctx, createdUser); err != nil {
+		log.Printf("send verification email to %s: %v", createdUser.Email, err)
+	}
+
 	return dto.NewUserResponse(createdUser), nil
 }
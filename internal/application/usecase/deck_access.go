@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// findEditableDeck loads a deck the actor may manage.
+func findEditableDeck(ctx context.Context, repo deck.Repository, actor user.Actor, id string) (*deck.Deck, error) {
+	deckId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, deck.ErrDeckNotFound
+	}
+
+	deckModel, err := repo.FindByID(ctx, deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	if deckModel == nil {
+		return nil, deck.ErrDeckNotFound
+	}
+
+	if err := deckModel.AuthorizeEdit(actor); err != nil {
+		return nil, err
+	}
+
+	return deckModel, nil
+}
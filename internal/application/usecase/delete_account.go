@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+// DeleteAccount lets users soft-delete their own account. An admin can still
+// restore it within the retention period.
+type DeleteAccount struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+	auditor        application.Auditor
+}
+
+func NewDeleteAccount(
+	userService *user.Service,
+	userRepository user.Repository,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *DeleteAccount {
+	return &DeleteAccount{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+		auditor:        auditor,
+	}
+}
+
+func (u *DeleteAccount) Execute(ctx context.Context, req dto.DeleteAccountRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return err
+	}
+
+	before := userSnapshot(userModel)
+
+	if err := u.userService.DeleteOwnAccount(ctx, userModel, req.Password); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserDelete, userModel.ID, userModel.ID, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel)))
+
+	return u.denylist.RevokeUser(ctx, userModel.ID)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+)
+
+type DeleteAssignment struct {
+	assignmentRepository assignment.Repository
+	cohortRepository     cohort.Repository
+}
+
+func NewDeleteAssignment(assignmentRepository assignment.Repository, cohortRepository cohort.Repository) *DeleteAssignment {
+	return &DeleteAssignment{
+		assignmentRepository: assignmentRepository,
+		cohortRepository:     cohortRepository,
+	}
+}
+
+// Execute removes the assignment; the students' review history is kept.
+func (u *DeleteAssignment) Execute(ctx context.Context, req dto.DeleteAssignmentRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	assignmentModel, err := findManagedAssignment(ctx, u.assignmentRepository, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.assignmentRepository.Delete(ctx, assignmentModel.ID)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+)
+
+type DeleteAvatar struct {
+	userService    *user.Service
+	userRepository user.Repository
+	storage        application.FileStorage
+}
+
+func NewDeleteAvatar(userService *user.Service, userRepository user.Repository, storage application.FileStorage) *DeleteAvatar {
+	return &DeleteAvatar{
+		userService:    userService,
+		userRepository: userRepository,
+		storage:        storage,
+	}
+}
+
+func (u *DeleteAvatar) Execute(ctx context.Context, req dto.DeleteAvatarRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel.Profile.AvatarKey == "" {
+		return nil, user.ErrAvatarNotFound
+	}
+
+	previous := u.userService.SetAvatar(userModel, "", "")
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	deleteStoredFile(ctx, u.storage, previous)
+
+	return dto.NewUserResponse(userModel), nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+)
+
+type DeleteCohort struct {
+	cohortRepository cohort.Repository
+}
+
+func NewDeleteCohort(cohortRepository cohort.Repository) *DeleteCohort {
+	return &DeleteCohort{
+		cohortRepository: cohortRepository,
+	}
+}
+
+// Execute removes the cohort and its memberships; the students' accounts
+// are kept.
+func (u *DeleteCohort) Execute(ctx context.Context, req dto.DeleteCohortRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.cohortRepository.Delete(ctx, cohortModel.ID)
+}
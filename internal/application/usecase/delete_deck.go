@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+)
+
+type DeleteDeck struct {
+	deckRepository deck.Repository
+}
+
+func NewDeleteDeck(deckRepository deck.Repository) *DeleteDeck {
+	return &DeleteDeck{
+		deckRepository: deckRepository,
+	}
+}
+
+// Execute retires the deck with its terms; the review history of students
+// who studied it is kept.
+func (u *DeleteDeck) Execute(ctx context.Context, req dto.DeleteDeckRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.deckRepository.Delete(ctx, deckModel.ID)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+
+	"github.com/google/uuid"
+)
+
+type DeleteTerm struct {
+	deckRepository deck.Repository
+}
+
+func NewDeleteTerm(deckRepository deck.Repository) *DeleteTerm {
+	return &DeleteTerm{
+		deckRepository: deckRepository,
+	}
+}
+
+func (u *DeleteTerm) Execute(ctx context.Context, req dto.DeleteTermRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	termId, err := uuid.Parse(req.TermId)
+	if err != nil {
+		return deck.ErrTermNotFound
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.DeckId)
+	if err != nil {
+		return err
+	}
+
+	if err := deckModel.RemoveTerm(termId); err != nil {
+		return err
+	}
+
+	return u.deckRepository.Update(ctx, deckModel)
+}
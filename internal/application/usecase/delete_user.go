@@ -4,35 +4,108 @@ import (
 	"context"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
 	"trainer/internal/domain/user"
 
 	"github.com/google/uuid"
 )
 
 type DeleteUser struct {
+	userService    *user.Service
 	userRepository user.Repository
+	denylist       application.TokenDenylist
+	storage        application.FileStorage
+	auditRepo      audit.Repository
+	auditor        application.Auditor
 }
 
-func NewDeleteUser(userRepository user.Repository) *DeleteUser {
+func NewDeleteUser(
+	userService *user.Service,
+	userRepository user.Repository,
+	denylist application.TokenDenylist,
+	storage application.FileStorage,
+	auditRepo audit.Repository,
+	auditor application.Auditor,
+) *DeleteUser {
 	return &DeleteUser{
+		userService:    userService,
 		userRepository: userRepository,
+		denylist:       denylist,
+		storage:        storage,
+		auditRepo:      auditRepo,
+		auditor:        auditor,
 	}
 }
 
+// Execute soft-deletes the user unless req.Hard asks for the account to be
+// erased right away, which also works on an already soft-deleted account.
 func (u *DeleteUser) Execute(ctx context.Context, req dto.DeleteUserRequest) error {
 	if errValidate := application.ValidateDTO(req); errValidate != nil {
 		return errValidate
 	}
 
+	if err := req.Actor.Authorize(user.PermissionUsersWrite); err != nil {
+		return err
+	}
+
 	userId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return user.ErrUserNotFound
+	}
+
+	if req.Hard {
+		return u.erase(ctx, req.Actor, userId)
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
 	if err != nil {
 		return err
 	}
 
-	err = u.userRepository.Delete(ctx, userId)
+	if userModel == nil {
+		return user.ErrUserNotFound
+	}
+
+	before := userSnapshot(userModel)
+
+	if err := u.userService.DeleteUser(ctx, userModel); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserDelete, req.Actor.ID, userId, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel)))
+
+	return u.denylist.RevokeUser(ctx, userId)
+}
+
+func (u *DeleteUser) erase(ctx context.Context, actor user.Actor, userId uuid.UUID) error {
+	userModel, err := u.userRepository.FindByIDIncludingDeleted(ctx, userId)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if userModel == nil {
+		return user.ErrUserNotFound
+	}
+
+	if err := u.auditRepo.Redact(ctx, []uuid.UUID{userId}, personalFields); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Delete(ctx, userId); err != nil {
+		return err
+	}
+
+	if userModel.Profile.AvatarKey != "" {
+		deleteStoredFile(ctx, u.storage, userModel.Profile.AvatarKey)
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserErase, actor.ID, userId, actor.Client).
+		SetChanges(erasedSnapshot(userModel), nil))
+
+	return u.denylist.RevokeUser(ctx, userId)
 }
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type DisableTOTP struct {
+	userService    *user.Service
+	userRepository user.Repository
+	auditor        application.Auditor
+}
+
+func NewDisableTOTP(userService *user.Service, userRepository user.Repository, auditor application.Auditor) *DisableTOTP {
+	return &DisableTOTP{
+		userService:    userService,
+		userRepository: userRepository,
+		auditor:        auditor,
+	}
+}
+
+func (u *DisableTOTP) Execute(ctx context.Context, req dto.DisableTOTPRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return user.ErrUserNotFound
+	}
+
+	if err := u.userService.DisableTOTP(ctx, userModel, req.Code); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionMFADisable, req.Actor.ID, userModel.ID, req.Actor.Client))
+
+	return nil
+}
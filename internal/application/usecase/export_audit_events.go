@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+var auditExportColumns = []string{
+	"id", "occurred_at", "action", "actor_id", "target_id", "ip", "user_agent", "before", "after", "metadata",
+}
+
+type ExportAuditEvents struct {
+	auditRepository audit.Repository
+}
+
+func NewExportAuditEvents(auditRepository audit.Repository) *ExportAuditEvents {
+	return &ExportAuditEvents{
+		auditRepository: auditRepository,
+	}
+}
+
+// Execute streams the matching events to w as CSV, newest first. Nothing is
+// written before the first page has been read.
+func (u *ExportAuditEvents) Execute(ctx context.Context, req dto.ExportAuditEventsRequest, w io.Writer) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionAuditRead); err != nil {
+		return err
+	}
+
+	query := newAuditQuery(req.AuditEventFilter)
+	query.Limit = exportPageSize
+
+	writer := csv.NewWriter(w)
+	headerWritten := false
+
+	for {
+		page, err := u.auditRepository.Find(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		if !headerWritten {
+			if err := writer.Write(auditExportColumns); err != nil {
+				return err
+			}
+			headerWritten = true
+		}
+
+		for _, event := range page.Events {
+			if err := writer.Write(auditRecord(dto.NewAuditEventResponse(event))); err != nil {
+				return err
+			}
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+
+		if page.Next == nil {
+			return nil
+		}
+		query.After = page.Next
+	}
+}
+
+func auditRecord(e *dto.AuditEventResponse) []string {
+	return []string{
+		e.ID,
+		e.OccurredAt.UTC().Format(time.RFC3339Nano),
+		e.Action,
+		e.ActorID,
+		e.TargetID,
+		spreadsheetSafe(e.IP),
+		spreadsheetSafe(e.UserAgent),
+		jsonCell(e.Before),
+		jsonCell(e.After),
+		jsonCell(e.Metadata),
+	}
+}
+
+// spreadsheetSafe defuses client-supplied text that a spreadsheet would
+// otherwise evaluate as a formula.
+func spreadsheetSafe(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// jsonCell encodes a change set for a single CSV cell; empty sets stay empty.
+func jsonCell(m map[string]any) string {
+	if len(m) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(m)
+	return string(data)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+var cohortAnalyticsExportColumns = []string{
+	"student_id", "email", "first_name", "last_name", "reviews", "recall_reviews", "retention_rate",
+	"time_spent_ms", "active_days", "current_streak", "longest_streak", "last_reviewed_at",
+}
+
+type ExportCohortAnalytics struct {
+	getCohortAnalytics *GetCohortAnalytics
+	userRepository     user.Repository
+}
+
+func NewExportCohortAnalytics(getCohortAnalytics *GetCohortAnalytics, userRepository user.Repository) *ExportCohortAnalytics {
+	return &ExportCohortAnalytics{
+		getCohortAnalytics: getCohortAnalytics,
+		userRepository:     userRepository,
+	}
+}
+
+// Execute writes one CSV row per student of the cohort to w. Names are
+// included so the file stands on its own; deleted students keep only their
+// ID.
+func (u *ExportCohortAnalytics) Execute(ctx context.Context, req dto.CohortAnalyticsRequest, w io.Writer) error {
+	report, err := u.getCohortAnalytics.Execute(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	users, err := u.findStudents(ctx, report.Students)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(cohortAnalyticsExportColumns); err != nil {
+		return err
+	}
+
+	for _, s := range report.Students {
+		var email, firstName, lastName string
+		if student, ok := users[s.StudentId]; ok {
+			email, firstName, lastName = student.Email, student.FirstName, student.LastName
+		}
+
+		var lastReviewedAt string
+		if s.LastReviewedAt != nil {
+			lastReviewedAt = s.LastReviewedAt.UTC().Format(time.RFC3339)
+		}
+
+		record := []string{
+			s.StudentId,
+			spreadsheetSafe(email),
+			spreadsheetSafe(firstName),
+			spreadsheetSafe(lastName),
+			strconv.Itoa(s.Reviews),
+			strconv.Itoa(s.RecallReviews),
+			rateCell(s.RetentionRate),
+			strconv.FormatInt(s.TimeSpentMs, 10),
+			strconv.Itoa(s.ActiveDays),
+			strconv.Itoa(s.CurrentStreak),
+			strconv.Itoa(s.LongestStreak),
+			lastReviewedAt,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (u *ExportCohortAnalytics) findStudents(ctx context.Context, students []*dto.StudentSummaryResponse) (map[string]*user.User, error) {
+	users := make(map[string]*user.User, len(students))
+	if len(students) == 0 {
+		return users, nil
+	}
+
+	ids := make([]uuid.UUID, len(students))
+	for i, s := range students {
+		ids[i], _ = uuid.Parse(s.StudentId)
+	}
+
+	page, err := u.userRepository.List(ctx, user.ListQuery{
+		IDs:    ids,
+		SortBy: user.SortByCreatedAt,
+		Limit:  len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, student := range page.Users {
+		users[student.ID.String()] = student
+	}
+
+	return users, nil
+}
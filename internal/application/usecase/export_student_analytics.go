@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"trainer/internal/application/dto"
+)
+
+var studentAnalyticsExportColumns = []string{
+	"date", "reviews", "recall_reviews", "retention_rate", "time_spent_ms",
+}
+
+type ExportStudentAnalytics struct {
+	getStudentAnalytics *GetStudentAnalytics
+}
+
+func NewExportStudentAnalytics(getStudentAnalytics *GetStudentAnalytics) *ExportStudentAnalytics {
+	return &ExportStudentAnalytics{
+		getStudentAnalytics: getStudentAnalytics,
+	}
+}
+
+// Execute writes the student's reviews per day to w as CSV.
+func (u *ExportStudentAnalytics) Execute(ctx context.Context, req dto.StudentAnalyticsRequest, w io.Writer) error {
+	report, err := u.getStudentAnalytics.Execute(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(studentAnalyticsExportColumns); err != nil {
+		return err
+	}
+
+	for _, d := range report.Days {
+		record := []string{
+			d.Date,
+			strconv.Itoa(d.Reviews),
+			strconv.Itoa(d.RecallReviews),
+			rateCell(d.RetentionRate),
+			strconv.FormatInt(d.TimeSpentMs, 10),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// rateCell leaves the cell empty when there is no rate.
+func rateCell(rate *float64) string {
+	if rate == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*rate, 'f', 4, 64)
+}
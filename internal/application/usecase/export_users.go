@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+)
+
+const exportPageSize = 500
+
+type ExportUsers struct {
+	userRepository user.Repository
+}
+
+func NewExportUsers(userRepository user.Repository) *ExportUsers {
+	return &ExportUsers{
+		userRepository: userRepository,
+	}
+}
+
+// Execute streams the users to w page by page, oldest first. Nothing is
+// written before the first page has been read.
+func (u *ExportUsers) Execute(ctx context.Context, req dto.ExportUsersRequest, w io.Writer) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionUsersReadAll); err != nil {
+		return err
+	}
+
+	encoder := newUserEncoder(w, req.Format)
+	query := user.ListQuery{
+		Role:   user.Role(req.Role),
+		SortBy: user.SortByCreatedAt,
+		Limit:  exportPageSize,
+	}
+
+	for {
+		page, err := u.userRepository.List(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, userModel := range page.Users {
+			if err := encoder.encode(dto.NewExportUserRow(userModel)); err != nil {
+				return err
+			}
+		}
+
+		if page.Next == nil {
+			return encoder.close()
+		}
+
+		if err := encoder.flush(); err != nil {
+			return err
+		}
+		query.After = page.Next
+	}
+}
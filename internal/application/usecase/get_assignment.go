@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+
+	"github.com/google/uuid"
+)
+
+type GetAssignment struct {
+	assignmentRepository assignment.Repository
+	cohortRepository     cohort.Repository
+}
+
+func NewGetAssignment(assignmentRepository assignment.Repository, cohortRepository cohort.Repository) *GetAssignment {
+	return &GetAssignment{
+		assignmentRepository: assignmentRepository,
+		cohortRepository:     cohortRepository,
+	}
+}
+
+// Execute returns the assignment with the status of every current member of
+// the cohort.
+func (u *GetAssignment) Execute(ctx context.Context, req dto.GetAssignmentRequest) (*dto.AssignmentDetailResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	assignmentModel, err := findManagedAssignment(ctx, u.assignmentRepository, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, assignmentModel.CohortID)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		studentIDs[i] = m.StudentID
+	}
+
+	progress, err := u.assignmentRepository.Progress(ctx, assignmentModel.DeckID, studentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewAssignmentDetailResponse(assignmentModel, progress, time.Now()), nil
+}
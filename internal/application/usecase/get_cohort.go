@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+)
+
+type GetCohort struct {
+	cohortRepository cohort.Repository
+}
+
+func NewGetCohort(cohortRepository cohort.Repository) *GetCohort {
+	return &GetCohort{
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *GetCohort) Execute(ctx context.Context, req dto.GetCohortRequest) (*dto.CohortResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCohortResponse(cohortModel, len(members), members), nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/cohort"
+
+	"github.com/google/uuid"
+)
+
+type GetCohortAnalytics struct {
+	analyticsRepository analytics.Repository
+	cohortRepository    cohort.Repository
+}
+
+func NewGetCohortAnalytics(analyticsRepository analytics.Repository, cohortRepository cohort.Repository) *GetCohortAnalytics {
+	return &GetCohortAnalytics{
+		analyticsRepository: analyticsRepository,
+		cohortRepository:    cohortRepository,
+	}
+}
+
+// Execute reports on the current members of a cohort the actor manages.
+func (u *GetCohortAnalytics) Execute(ctx context.Context, req dto.CohortAnalyticsRequest) (*dto.CohortAnalyticsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	rg, today, err := newAnalyticsRange(req.AnalyticsFilter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		ids[i] = m.StudentID
+	}
+
+	summaries, err := u.analyticsRepository.Students(ctx, ids, rg, today)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := u.analyticsRepository.Daily(ctx, ids, rg)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := u.analyticsRepository.HardestTerms(ctx, ids, hardestTermsOwner(req.Actor, false), rg, hardestTermsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	students := make([]*dto.StudentSummaryResponse, len(summaries))
+	for i, s := range summaries {
+		students[i] = dto.NewStudentSummaryResponse(s)
+	}
+
+	return &dto.CohortAnalyticsResponse{
+		AnalyticsRangeResponse: dto.NewAnalyticsRangeResponse(rg),
+		CohortId:               cohortModel.ID.String(),
+		Summary:                dto.NewCohortSummaryResponse(summaries),
+		Days:                   dto.NewAnalyticsDaysResponse(analytics.FillDays(days, rg)),
+		HardestTerms:           dto.NewHardTermsResponse(terms),
+		Students:               students,
+	}, nil
+}
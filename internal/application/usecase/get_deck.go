@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+)
+
+type GetDeck struct {
+	deckRepository deck.Repository
+}
+
+func NewGetDeck(deckRepository deck.Repository) *GetDeck {
+	return &GetDeck{
+		deckRepository: deckRepository,
+	}
+}
+
+func (u *GetDeck) Execute(ctx context.Context, req dto.GetDeckRequest) (*dto.DeckResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewDeckResponse(deckModel), nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type GetStudentAnalytics struct {
+	analyticsRepository analytics.Repository
+	userRepository      user.Repository
+	cohortRepository    cohort.Repository
+}
+
+func NewGetStudentAnalytics(analyticsRepository analytics.Repository, userRepository user.Repository, cohortRepository cohort.Repository) *GetStudentAnalytics {
+	return &GetStudentAnalytics{
+		analyticsRepository: analyticsRepository,
+		userRepository:      userRepository,
+		cohortRepository:    cohortRepository,
+	}
+}
+
+// Execute reports on one student; students may read their own report and
+// mentors those of their students.
+func (u *GetStudentAnalytics) Execute(ctx context.Context, req dto.StudentAnalyticsRequest) (*dto.StudentAnalyticsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	if err := authorizeUserRead(ctx, u.cohortRepository, req.Actor, studentId); err != nil {
+		return nil, err
+	}
+
+	student, err := u.userRepository.FindByID(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if student == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	rg, today, err := newAnalyticsRange(req.AnalyticsFilter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uuid.UUID{student.ID}
+
+	summaries, err := u.analyticsRepository.Students(ctx, ids, rg, today)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := u.analyticsRepository.Daily(ctx, ids, rg)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := u.analyticsRepository.HardestTerms(ctx, ids, hardestTermsOwner(req.Actor, req.Actor.ID == student.ID), rg, hardestTermsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.StudentAnalyticsResponse{
+		AnalyticsRangeResponse: dto.NewAnalyticsRangeResponse(rg),
+		Summary:                dto.NewStudentSummaryResponse(summaries[0]),
+		Days:                   dto.NewAnalyticsDaysResponse(analytics.FillDays(days, rg)),
+		HardestTerms:           dto.NewHardTermsResponse(terms),
+	}, nil
+}
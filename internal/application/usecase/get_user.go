@@ -2,21 +2,23 @@ package usecase
 
 import (
 	"context"
-	"errors"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
 	"trainer/internal/domain/user"
 
 	"github.com/google/uuid"
 )
 
 type GetUser struct {
-	userRepository user.Repository
+	userRepository   user.Repository
+	cohortRepository cohort.Repository
 }
 
-func NewGetUser(userRepository user.Repository) *GetUser {
+func NewGetUser(userRepository user.Repository, cohortRepository cohort.Repository) *GetUser {
 	return &GetUser{
-		userRepository: userRepository,
+		userRepository:   userRepository,
+		cohortRepository: cohortRepository,
 	}
 }
 
@@ -27,6 +29,10 @@ func (u *GetUser) Execute(ctx context.Context, req dto.GetUserRequest) (*dto.Use
 
 	userId, err := uuid.Parse(req.Id)
 	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	if err := authorizeUserRead(ctx, u.cohortRepository, req.Actor, userId); err != nil {
 		return nil, err
 	}
 
@@ -37,7 +43,7 @@ func (u *GetUser) Execute(ctx context.Context, req dto.GetUserRequest) (*dto.Use
 	}
 
 	if userModel == nil {
-		return nil, errors.New("Not existed user")
+		return nil, user.ErrUserNotFound
 	}
 
 	return dto.NewUserResponse(userModel), nil
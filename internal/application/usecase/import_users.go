@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type ImportUsers struct {
+	userService        *user.Service
+	userRepository     user.Repository
+	verificationMailer *VerificationMailer
+	auditor            application.Auditor
+}
+
+func NewImportUsers(
+	userService *user.Service,
+	userRepository user.Repository,
+	verificationMailer *VerificationMailer,
+	auditor application.Auditor,
+) *ImportUsers {
+	return &ImportUsers{
+		userService:        userService,
+		userRepository:     userRepository,
+		verificationMailer: verificationMailer,
+		auditor:            auditor,
+	}
+}
+
+// Execute creates every user of the file in one transaction, or none of them
+// when a row is invalid; the report lists the rejected rows either way.
+func (u *ImportUsers) Execute(ctx context.Context, req dto.ImportUsersRequest) (*dto.ImportUsersResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+
+	rows, err := decodeImportRows(req.Data, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating accounts with more than student rights is a role assignment.
+	if slices.ContainsFunc(rows, func(row dto.ImportUserRow) bool {
+		return row.Role != "" && user.Role(row.Role) != user.RoleStudent
+	}) {
+		if err := req.Actor.Authorize(user.PermissionRolesManage); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &dto.ImportUsersResponse{
+		Total:  len(rows),
+		DryRun: req.DryRun,
+		Errors: []dto.ImportRowError{},
+	}
+
+	users := make([]*user.User, 0, len(rows))
+	seen := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		email := strings.ToLower(row.Email)
+		if seen[email] {
+			resp.Errors = append(resp.Errors, newImportRowError(i, row, user.ErrEmailAlreadyUsed))
+			continue
+		}
+		seen[email] = true
+
+		createdUser, err := u.newUser(ctx, row)
+		if err != nil {
+			resp.Errors = append(resp.Errors, newImportRowError(i, row, err))
+			continue
+		}
+		users = append(users, createdUser)
+	}
+
+	resp.Valid = len(users)
+	if len(resp.Errors) > 0 || req.DryRun {
+		return resp, nil
+	}
+
+	if err := u.userRepository.SaveAll(ctx, users); err != nil {
+		return nil, err
+	}
+	resp.Imported = len(users)
+
+	for _, createdUser := range users {
+		u.auditor.Record(ctx, newAuditEvent(audit.ActionUserCreate, req.Actor.ID, createdUser.ID, req.Actor.Client).
+			SetChanges(nil, userSnapshot(createdUser)).
+			WithMetadata("source", "import"))
+
+		if err := u.verificationMailer.Send(ctx, createdUser); err != nil {
+			log.Printf("send verification email to %s: %v", createdUser.Email, err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (u *ImportUsers) newUser(ctx context.Context, row dto.ImportUserRow) (*user.User, error) {
+	if err := application.ValidateDTO(row); err != nil {
+		return nil, err
+	}
+
+	role := user.RoleStudent
+	if row.Role != "" {
+		role = user.Role(row.Role)
+	}
+
+	return u.userService.NewUser(ctx, row.Email, row.FirstName, row.LastName, row.Password, role)
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+)
+
+type InviteCohortMember struct {
+	cohortRepository cohort.Repository
+	userRepository   user.Repository
+	mailer           application.Mailer
+	appURL           string
+}
+
+func NewInviteCohortMember(
+	cohortRepository cohort.Repository,
+	userRepository user.Repository,
+	mailer application.Mailer,
+	appURL string,
+) *InviteCohortMember {
+	return &InviteCohortMember{
+		cohortRepository: cohortRepository,
+		userRepository:   userRepository,
+		mailer:           mailer,
+		appURL:           appURL,
+	}
+}
+
+// Execute emails the join code to a student, who becomes a member only by
+// joining with it. It succeeds for emails that do not belong to a student
+// too, so mentors cannot use it to find out who has an account.
+func (u *InviteCohortMember) Execute(ctx context.Context, req dto.InviteCohortMemberRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return err
+	}
+
+	student, err := u.userRepository.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return err
+	}
+
+	if student == nil || !student.IsStudent() {
+		return nil
+	}
+
+	link := fmt.Sprintf("%s/cohorts/join?code=%s", u.appURL, url.QueryEscape(cohortModel.JoinCode))
+
+	return u.mailer.Send(ctx, application.Message{
+		To:      student.Email,
+		Subject: fmt.Sprintf("Join %s", cohortModel.Name),
+		Body: fmt.Sprintf(
+			"You have been invited to join the cohort %q.\n\nOpen the link below to join, or enter the code %s:\n%s\n\nIf you do not want to join, ignore this email.",
+			cohortModel.Name, cohortModel.JoinCode, link,
+		),
+	})
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+)
+
+type JoinCohort struct {
+	cohortRepository cohort.Repository
+	userRepository   user.Repository
+}
+
+func NewJoinCohort(cohortRepository cohort.Repository, userRepository user.Repository) *JoinCohort {
+	return &JoinCohort{
+		cohortRepository: cohortRepository,
+		userRepository:   userRepository,
+	}
+}
+
+// Execute adds the actor to the cohort with the join code. Unknown and
+// malformed codes are reported alike.
+func (u *JoinCohort) Execute(ctx context.Context, req dto.JoinCohortRequest) (*dto.StudentCohortResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	student, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := cohort.NormalizeJoinCode(req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := u.cohortRepository.FindByJoinCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if cohortModel == nil {
+		return nil, cohort.ErrInvalidJoinCode
+	}
+
+	member, err := cohort.NewMember(cohortModel.ID, student)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.cohortRepository.AddMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewStudentCohortResponse(cohortModel, len(members)), nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+
+	"github.com/google/uuid"
+)
+
+type LeaveCohort struct {
+	cohortRepository cohort.Repository
+}
+
+func NewLeaveCohort(cohortRepository cohort.Repository) *LeaveCohort {
+	return &LeaveCohort{
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *LeaveCohort) Execute(ctx context.Context, req dto.LeaveCohortRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	cohortId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return cohort.ErrNotMember
+	}
+
+	return u.cohortRepository.RemoveMember(ctx, cohortId, req.Actor.ID)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+)
+
+type ListAssignments struct {
+	assignmentRepository assignment.Repository
+	cohortRepository     cohort.Repository
+}
+
+func NewListAssignments(assignmentRepository assignment.Repository, cohortRepository cohort.Repository) *ListAssignments {
+	return &ListAssignments{
+		assignmentRepository: assignmentRepository,
+		cohortRepository:     cohortRepository,
+	}
+}
+
+// Execute lists the assignments of a cohort the actor manages.
+func (u *ListAssignments) Execute(ctx context.Context, req dto.ListAssignmentsRequest) (*dto.ListAssignmentsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := u.assignmentRepository.List(ctx, assignment.ListQuery{CohortID: cohortModel.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewAssignmentsResponse(assignments), nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+const defaultAuditLimit = 50
+
+type ListAuditEvents struct {
+	auditRepository audit.Repository
+}
+
+func NewListAuditEvents(auditRepository audit.Repository) *ListAuditEvents {
+	return &ListAuditEvents{
+		auditRepository: auditRepository,
+	}
+}
+
+func (u *ListAuditEvents) Execute(ctx context.Context, req dto.ListAuditEventsRequest) (*dto.ListAuditEventsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionAuditRead); err != nil {
+		return nil, err
+	}
+
+	query := newAuditQuery(req.AuditEventFilter)
+	query.Limit = req.Limit
+	if query.Limit == 0 {
+		query.Limit = defaultAuditLimit
+	}
+
+	if req.Cursor != "" {
+		cursor, err := audit.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+
+	page, err := u.auditRepository.Find(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewAuditEventsResponse(page), nil
+}
+
+// newAuditQuery expects a filter the validator has already checked.
+func newAuditQuery(filter dto.AuditEventFilter) audit.Query {
+	query := audit.Query{Action: audit.Action(filter.Action)}
+
+	if filter.ActorID != "" {
+		query.ActorID, _ = uuid.Parse(filter.ActorID)
+	}
+	if filter.TargetID != "" {
+		query.TargetID, _ = uuid.Parse(filter.TargetID)
+	}
+	if filter.From != "" {
+		from, _ := time.Parse(time.RFC3339, filter.From)
+		from = from.UTC()
+		query.From = &from
+	}
+	if filter.To != "" {
+		to, _ := time.Parse(time.RFC3339, filter.To)
+		to = to.UTC()
+		query.To = &to
+	}
+
+	return query
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListCohorts struct {
+	cohortRepository cohort.Repository
+}
+
+func NewListCohorts(cohortRepository cohort.Repository) *ListCohorts {
+	return &ListCohorts{
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *ListCohorts) Execute(ctx context.Context, req dto.ListCohortsRequest) (*dto.ListCohortsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return nil, err
+	}
+
+	var query cohort.ListQuery
+
+	// The validator has already checked the format.
+	if req.MentorId != "" {
+		query.MentorID, _ = uuid.Parse(req.MentorId)
+	}
+	if !req.Actor.Role.Can(user.PermissionCoursesAdmin) {
+		if query.MentorID != uuid.Nil && query.MentorID != req.Actor.ID {
+			return nil, user.ErrAccessDenied
+		}
+		query.MentorID = req.Actor.ID
+	}
+
+	summaries, err := u.cohortRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCohortsResponse(summaries), nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListDecks struct {
+	deckRepository deck.Repository
+}
+
+func NewListDecks(deckRepository deck.Repository) *ListDecks {
+	return &ListDecks{
+		deckRepository: deckRepository,
+	}
+}
+
+func (u *ListDecks) Execute(ctx context.Context, req dto.ListDecksRequest) (*dto.ListDecksResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return nil, err
+	}
+
+	query := deck.ListQuery{
+		Search: strings.TrimSpace(req.Search),
+		Limit:  req.Limit,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultListLimit
+	}
+	if req.Page > 1 {
+		query.Offset = (req.Page - 1) * query.Limit
+	}
+
+	// The validator has already checked the format.
+	if req.OwnerId != "" {
+		query.OwnerID, _ = uuid.Parse(req.OwnerId)
+	}
+	if !req.Actor.Role.Can(user.PermissionCoursesAdmin) {
+		if query.OwnerID != uuid.Nil && query.OwnerID != req.Actor.ID {
+			return nil, user.ErrAccessDenied
+		}
+		query.OwnerID = req.Actor.ID
+	}
+
+	page, err := u.deckRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewDecksResponse(page), nil
+}
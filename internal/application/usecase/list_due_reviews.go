@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/review"
+
+	"github.com/google/uuid"
+)
+
+type ListDueReviews struct {
+	reviewRepository     review.Repository
+	deckRepository       deck.Repository
+	assignmentRepository assignment.Repository
+	reviewService        *review.Service
+}
+
+func NewListDueReviews(
+	reviewRepository review.Repository,
+	deckRepository deck.Repository,
+	assignmentRepository assignment.Repository,
+	reviewService *review.Service,
+) *ListDueReviews {
+	return &ListDueReviews{
+		reviewRepository:     reviewRepository,
+		deckRepository:       deckRepository,
+		assignmentRepository: assignmentRepository,
+		reviewService:        reviewService,
+	}
+}
+
+// Execute lists the due terms of the decks assigned to the actor's cohorts.
+func (u *ListDueReviews) Execute(ctx context.Context, req dto.DueReviewsRequest) (*dto.DueReviewsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	deckIDs, err := assignedDecks(ctx, u.assignmentRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The validator has already checked the format.
+	var deckID uuid.UUID
+	if req.DeckId != "" {
+		deckID, _ = uuid.Parse(req.DeckId)
+		if !slices.Contains(deckIDs, deckID) {
+			return nil, deck.ErrDeckNotFound
+		}
+		deckIDs = []uuid.UUID{deckID}
+	}
+
+	states, err := u.reviewRepository.FindDue(ctx, req.Actor.ID, deckIDs, u.reviewService.Now(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var newTermIDs []uuid.UUID
+	if req.NewLimit > 0 {
+		newTermIDs, err = u.reviewRepository.FindNewTerms(ctx, req.Actor.ID, deckID, req.NewLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	termIDs := make([]uuid.UUID, 0, len(states)+len(newTermIDs))
+	for _, s := range states {
+		termIDs = append(termIDs, s.TermID)
+	}
+	termIDs = append(termIDs, newTermIDs...)
+
+	terms, err := u.deckRepository.FindTerms(ctx, termIDs)
+	if err != nil {
+		return nil, err
+	}
+	termsByID := make(map[uuid.UUID]*deck.Term, len(terms))
+	for _, term := range terms {
+		termsByID[term.ID] = term
+	}
+
+	reviews := make([]*dto.DueReviewResponse, 0, len(termIDs))
+	for _, s := range states {
+		if term, ok := termsByID[s.TermID]; ok {
+			reviews = append(reviews, &dto.DueReviewResponse{
+				Term:  dto.NewTermResponse(term),
+				State: dto.NewReviewStateResponse(s),
+			})
+		}
+	}
+	for _, id := range newTermIDs {
+		if term, ok := termsByID[id]; ok {
+			reviews = append(reviews, &dto.DueReviewResponse{Term: dto.NewTermResponse(term)})
+		}
+	}
+
+	return &dto.DueReviewsResponse{Reviews: reviews}, nil
+}
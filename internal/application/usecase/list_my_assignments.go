@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListMyAssignments struct {
+	assignmentRepository assignment.Repository
+}
+
+func NewListMyAssignments(assignmentRepository assignment.Repository) *ListMyAssignments {
+	return &ListMyAssignments{
+		assignmentRepository: assignmentRepository,
+	}
+}
+
+// Execute lists the assignments of the actor's cohorts with their progress.
+func (u *ListMyAssignments) Execute(ctx context.Context, req dto.MyAssignmentsRequest) (*dto.MyAssignmentsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	// A zero ID would lift the filter.
+	if req.Actor.ID == uuid.Nil {
+		return nil, user.ErrAccessDenied
+	}
+
+	assignments, err := u.assignmentRepository.List(ctx, assignment.ListQuery{StudentID: req.Actor.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	deckIDs := make([]uuid.UUID, len(assignments))
+	for i, a := range assignments {
+		deckIDs[i] = a.DeckID
+	}
+
+	progress, err := u.assignmentRepository.StudentProgress(ctx, req.Actor.ID, deckIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := make([]*dto.StudentAssignmentResponse, len(assignments))
+	for i, a := range assignments {
+		resp[i] = &dto.StudentAssignmentResponse{
+			AssignmentResponse: dto.NewAssignmentResponse(a),
+			Progress:           dto.NewAssignmentProgressResponse(a, progress[i], now),
+		}
+	}
+
+	return &dto.MyAssignmentsResponse{Assignments: resp}, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListMyCohorts struct {
+	cohortRepository cohort.Repository
+}
+
+func NewListMyCohorts(cohortRepository cohort.Repository) *ListMyCohorts {
+	return &ListMyCohorts{
+		cohortRepository: cohortRepository,
+	}
+}
+
+// Execute lists the cohorts the actor is a member of.
+func (u *ListMyCohorts) Execute(ctx context.Context, req dto.MyCohortsRequest) (*dto.MyCohortsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	// A zero ID would lift the filter.
+	if req.Actor.ID == uuid.Nil {
+		return nil, user.ErrAccessDenied
+	}
+
+	summaries, err := u.cohortRepository.List(ctx, cohort.ListQuery{StudentID: req.Actor.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewMyCohortsResponse(summaries), nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListRoleChanges struct {
+	roleChangeRepository user.RoleChangeRepository
+}
+
+func NewListRoleChanges(roleChangeRepository user.RoleChangeRepository) *ListRoleChanges {
+	return &ListRoleChanges{
+		roleChangeRepository: roleChangeRepository,
+	}
+}
+
+func (u *ListRoleChanges) Execute(ctx context.Context, req dto.ListRoleChangesRequest) (*dto.ListRoleChangesResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionRolesManage); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	changes, err := u.roleChangeRepository.FindByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewRoleChangesResponse(changes), nil
+}
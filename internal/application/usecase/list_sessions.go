@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ListSessions struct {
+	userRepository user.Repository
+}
+
+func NewListSessions(userRepository user.Repository) *ListSessions {
+	return &ListSessions{
+		userRepository: userRepository,
+	}
+}
+
+func (u *ListSessions) Execute(ctx context.Context, req dto.ListSessionsRequest) (*dto.ListSessionsResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	return dto.NewSessionsResponse(userModel.GetRefreshTokens()), nil
+}
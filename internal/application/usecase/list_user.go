@@ -2,18 +2,30 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
 	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultListLimit = 20
+	defaultListSort  = "-created_at"
 )
 
 type ListUser struct {
-	userRepository user.Repository
+	userRepository   user.Repository
+	cohortRepository cohort.Repository
 }
 
-func NewListUser(userRepository user.Repository) *ListUser {
+func NewListUser(userRepository user.Repository, cohortRepository cohort.Repository) *ListUser {
 	return &ListUser{
-		userRepository: userRepository,
+		userRepository:   userRepository,
+		cohortRepository: cohortRepository,
 	}
 }
 
@@ -22,9 +34,83 @@ func (u *ListUser) Execute(ctx context.Context, req dto.ListUserRequest) (*dto.L
 		return nil, err
 	}
 
-	users, err := u.userRepository.FindAll(ctx)
+	ids, err := readableUserIDs(ctx, u.cohortRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The members of a cohort the actor manages are all readable by them.
+	if req.CohortId != "" {
+		cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.CohortId)
+		if err != nil {
+			return nil, err
+		}
+
+		members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = make([]uuid.UUID, len(members))
+		for i, m := range members {
+			ids[i] = m.StudentID
+		}
+	}
+
+	query, err := newListQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	query.IDs = ids
+
+	page, err := u.userRepository.List(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return dto.NewUsersResponse(users), nil
+	return dto.NewUsersResponse(page), nil
+}
+
+func newListQuery(req dto.ListUserRequest) (user.ListQuery, error) {
+	query := user.ListQuery{
+		Role:   user.Role(req.Role),
+		Search: strings.TrimSpace(req.Search),
+		Limit:  req.Limit,
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultListLimit
+	}
+	if req.Page > 1 {
+		query.Offset = (req.Page - 1) * query.Limit
+	}
+
+	sort := req.Sort
+	if sort == "" {
+		sort = defaultListSort
+	}
+	query.Descending = strings.HasPrefix(sort, "-")
+	query.SortBy = user.SortField(strings.TrimPrefix(sort, "-"))
+
+	// The validator has already checked the format.
+	if req.CreatedFrom != "" {
+		from, _ := time.Parse(time.RFC3339, req.CreatedFrom)
+		query.CreatedFrom = &from
+	}
+	if req.CreatedTo != "" {
+		to, _ := time.Parse(time.RFC3339, req.CreatedTo)
+		query.CreatedTo = &to
+	}
+
+	if req.Cursor != "" {
+		cursor, err := user.DecodeListCursor(req.Cursor)
+		if err != nil {
+			return query, err
+		}
+		if cursor.SortBy != query.SortBy || cursor.Descending != query.Descending {
+			return query, user.ErrInvalidCursor
+		}
+		query.After = cursor
+	}
+
+	return query, nil
 }
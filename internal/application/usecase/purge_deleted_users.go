@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// PurgeDeletedUsers permanently erases soft-deleted accounts whose retention
+// period is over. It runs as a background job rather than per request.
+type PurgeDeletedUsers struct {
+	userService    *user.Service
+	userRepository user.Repository
+	storage        application.FileStorage
+	auditRepo      audit.Repository
+	auditor        application.Auditor
+}
+
+func NewPurgeDeletedUsers(
+	userService *user.Service,
+	userRepository user.Repository,
+	storage application.FileStorage,
+	auditRepo audit.Repository,
+	auditor application.Auditor,
+) *PurgeDeletedUsers {
+	return &PurgeDeletedUsers{
+		userService:    userService,
+		userRepository: userRepository,
+		storage:        storage,
+		auditRepo:      auditRepo,
+		auditor:        auditor,
+	}
+}
+
+func (u *PurgeDeletedUsers) Execute(ctx context.Context) (int, error) {
+	purged, err := u.userRepository.PurgeDeleted(ctx, u.userService.PurgeCutoff(time.Now()))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(purged) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, len(purged))
+	for i, p := range purged {
+		ids[i] = p.ID
+		if p.AvatarKey != "" {
+			deleteStoredFile(ctx, u.storage, p.AvatarKey)
+		}
+	}
+
+	if err := u.auditRepo.Redact(ctx, ids, personalFields); err != nil {
+		return len(purged), err
+	}
+
+	for _, id := range ids {
+		u.auditor.Record(ctx, newAuditEvent(audit.ActionUserPurge, uuid.Nil, id, user.ClientInfo{}))
+	}
+
+	return len(purged), nil
+}
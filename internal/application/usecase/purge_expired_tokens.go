@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/domain/user"
+)
+
+// PurgeExpiredTokens removes the refresh tokens of sessions that expired.
+// Rotated tokens are kept until then to detect their reuse. It runs as a
+// background job rather than per request.
+type PurgeExpiredTokens struct {
+	userRepository user.Repository
+}
+
+func NewPurgeExpiredTokens(userRepository user.Repository) *PurgeExpiredTokens {
+	return &PurgeExpiredTokens{
+		userRepository: userRepository,
+	}
+}
+
+func (u *PurgeExpiredTokens) Execute(ctx context.Context) (int, error) {
+	return u.userRepository.PurgeExpiredTokens(ctx, time.Now())
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+
+	"github.com/google/uuid"
+)
+
+type RemoveCohortMember struct {
+	cohortRepository cohort.Repository
+}
+
+func NewRemoveCohortMember(cohortRepository cohort.Repository) *RemoveCohortMember {
+	return &RemoveCohortMember{
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *RemoveCohortMember) Execute(ctx context.Context, req dto.RemoveCohortMemberRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return err
+	}
+
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return cohort.ErrNotMember
+	}
+
+	return u.cohortRepository.RemoveMember(ctx, cohortModel.ID, studentId)
+}
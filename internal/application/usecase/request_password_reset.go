@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+)
+
+type RequestPasswordReset struct {
+	userService     *user.Service
+	userRepository  user.Repository
+	resetRepository user.PasswordResetRepository
+	mailer          application.Mailer
+	appURL          string
+}
+
+func NewRequestPasswordReset(
+	userService *user.Service,
+	userRepository user.Repository,
+	resetRepository user.PasswordResetRepository,
+	mailer application.Mailer,
+	appURL string,
+) *RequestPasswordReset {
+	return &RequestPasswordReset{
+		userService:     userService,
+		userRepository:  userRepository,
+		resetRepository: resetRepository,
+		mailer:          mailer,
+		appURL:          appURL,
+	}
+}
+
+// Execute succeeds for unknown emails too, so the endpoint cannot be used to
+// find out which accounts exist.
+func (u *RequestPasswordReset) Execute(ctx context.Context, req dto.RequestPasswordResetRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userModel, err := u.userRepository.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return nil
+	}
+
+	token, secret, err := u.userService.RequestPasswordReset(ctx, userModel)
+	if err != nil {
+		return err
+	}
+
+	if err := u.resetRepository.Save(ctx, token); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/password/reset?token=%s", u.appURL, url.QueryEscape(secret))
+
+	return u.mailer.Send(ctx, application.Message{
+		To:      userModel.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf(
+			"Someone requested a password reset for your account.\n\nOpen the link below to choose a new password:\n%s\n\nThe link expires at %s. If you did not request it, ignore this email.",
+			link, token.ExpiresAt.Format("2006-01-02 15:04 MST"),
+		),
+	})
+}
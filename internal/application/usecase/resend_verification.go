@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+)
+
+type ResendVerification struct {
+	userRepository     user.Repository
+	verificationMailer *VerificationMailer
+}
+
+func NewResendVerification(userRepository user.Repository, verificationMailer *VerificationMailer) *ResendVerification {
+	return &ResendVerification{
+		userRepository:     userRepository,
+		verificationMailer: verificationMailer,
+	}
+}
+
+// Execute reports success for unknown and already verified emails alike, so
+// it does not reveal which accounts exist.
+func (u *ResendVerification) Execute(ctx context.Context, req dto.ResendVerificationRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userModel, err := u.userRepository.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return nil
+	}
+
+	err = u.verificationMailer.Send(ctx, userModel)
+	if errors.Is(err, user.ErrEmailVerified) {
+		return nil
+	}
+
+	return err
+}
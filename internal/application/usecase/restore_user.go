@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type RestoreUser struct {
+	userService    *user.Service
+	userRepository user.Repository
+	auditor        application.Auditor
+}
+
+func NewRestoreUser(userService *user.Service, userRepository user.Repository, auditor application.Auditor) *RestoreUser {
+	return &RestoreUser{
+		userService:    userService,
+		userRepository: userRepository,
+		auditor:        auditor,
+	}
+}
+
+func (u *RestoreUser) Execute(ctx context.Context, req dto.RestoreUserRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByIDIncludingDeleted(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	before := userSnapshot(userModel)
+
+	if err := u.userService.RestoreUser(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserRestore, req.Actor.ID, userId, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel)))
+
+	return dto.NewUserResponse(userModel), nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type RevokeSession struct {
+	userService    *user.Service
+	userRepository user.Repository
+	denylist       application.TokenDenylist
+}
+
+func NewRevokeSession(userService *user.Service, userRepository user.Repository, denylist application.TokenDenylist) *RevokeSession {
+	return &RevokeSession{
+		userService:    userService,
+		userRepository: userRepository,
+		denylist:       denylist,
+	}
+}
+
+func (u *RevokeSession) Execute(ctx context.Context, req dto.RevokeSessionRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return user.ErrUserNotFound
+	}
+
+	sessionId, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return user.ErrSessionNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return user.ErrUserNotFound
+	}
+
+	if err := u.userService.RevokeSession(ctx, userModel, sessionId); err != nil {
+		return err
+	}
+
+	if err := u.userRepository.UpdateSessions(ctx, userModel); err != nil {
+		return err
+	}
+
+	return revokeEndedSessions(ctx, u.denylist, userModel)
+}
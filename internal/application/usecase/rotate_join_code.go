@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+)
+
+type RotateJoinCode struct {
+	cohortRepository cohort.Repository
+}
+
+func NewRotateJoinCode(cohortRepository cohort.Repository) *RotateJoinCode {
+	return &RotateJoinCode{
+		cohortRepository: cohortRepository,
+	}
+}
+
+// Execute replaces the join code, e.g. after it leaked; current members stay.
+func (u *RotateJoinCode) Execute(ctx context.Context, req dto.RotateJoinCodeRequest) (*dto.CohortResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cohortModel.RotateJoinCode(); err != nil {
+		return nil, err
+	}
+
+	err = storeWithFreshCode(cohortModel, func() error {
+		return u.cohortRepository.Update(ctx, cohortModel)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCohortResponse(cohortModel, len(members), members), nil
+}
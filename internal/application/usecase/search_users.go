@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/user"
+)
+
+const defaultSearchLimit = 10
+
+type SearchUsers struct {
+	userRepository   user.Repository
+	cohortRepository cohort.Repository
+}
+
+func NewSearchUsers(userRepository user.Repository, cohortRepository cohort.Repository) *SearchUsers {
+	return &SearchUsers{
+		userRepository:   userRepository,
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *SearchUsers) Execute(ctx context.Context, req dto.SearchUsersRequest) (*dto.SearchUsersResponse, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	ids, err := readableUserIDs(ctx, u.cohortRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	query := user.SearchQuery{
+		IDs:   ids,
+		Text:  req.Query,
+		Role:  user.Role(req.Role),
+		Limit: req.Limit,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultSearchLimit
+	}
+
+	results, err := u.userRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewSearchUsersResponse(results, req.Query), nil
+}
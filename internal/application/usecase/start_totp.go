@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type StartTOTP struct {
+	userService    *user.Service
+	userRepository user.Repository
+}
+
+func NewStartTOTP(userService *user.Service, userRepository user.Repository) *StartTOTP {
+	return &StartTOTP{
+		userService:    userService,
+		userRepository: userRepository,
+	}
+}
+
+func (u *StartTOTP) Execute(ctx context.Context, req dto.StartTOTPRequest) (*dto.TOTPEnrollmentResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	secret, uri, err := u.userService.StartTOTPEnrollment(ctx, userModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	return &dto.TOTPEnrollmentResponse{Secret: secret, URI: uri}, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/review"
+
+	"github.com/google/uuid"
+)
+
+type SubmitReview struct {
+	reviewRepository     review.Repository
+	deckRepository       deck.Repository
+	assignmentRepository assignment.Repository
+	reviewService        *review.Service
+}
+
+func NewSubmitReview(
+	reviewRepository review.Repository,
+	deckRepository deck.Repository,
+	assignmentRepository assignment.Repository,
+	reviewService *review.Service,
+) *SubmitReview {
+	return &SubmitReview{
+		reviewRepository:     reviewRepository,
+		deckRepository:       deckRepository,
+		assignmentRepository: assignmentRepository,
+		reviewService:        reviewService,
+	}
+}
+
+// Execute records an answer to a term of a deck assigned to the actor's
+// cohorts.
+func (u *SubmitReview) Execute(ctx context.Context, req dto.SubmitReviewRequest) (*dto.ReviewStateResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	termID, _ := uuid.Parse(req.TermId)
+	terms, err := u.deckRepository.FindTerms(ctx, []uuid.UUID{termID})
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, deck.ErrTermNotFound
+	}
+	term := terms[0]
+
+	deckIDs, err := assignedDecks(ctx, u.assignmentRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(deckIDs, term.DeckID) {
+		return nil, deck.ErrTermNotFound
+	}
+
+	state, err := u.reviewRepository.FindState(ctx, req.Actor.ID, term.ID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = review.NewState(req.Actor.ID, term.ID, term.DeckID, u.reviewService.Now())
+	}
+	state.DeckID = term.DeckID
+
+	log, err := u.reviewService.Review(state, review.Grade(req.Grade), time.Duration(req.DurationMs)*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.reviewRepository.Save(ctx, state, log); err != nil {
+		return nil, err
+	}
+
+	return dto.NewReviewStateResponse(state), nil
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/domain/user"
+)
+
+// revokeEndedSessions denylists the access tokens of every session the user
+// lost in this request. Call it after the user has been persisted.
+func revokeEndedSessions(ctx context.Context, denylist application.TokenDenylist, u *user.User) error {
+	for _, sessionID := range u.EndedSessions() {
+		if err := denylist.RevokeSession(ctx, sessionID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type UnlockUser struct {
+	userRepository user.Repository
+	loginGuard     *user.LoginGuard
+	auditor        application.Auditor
+}
+
+func NewUnlockUser(userRepository user.Repository, loginGuard *user.LoginGuard, auditor application.Auditor) *UnlockUser {
+	return &UnlockUser{
+		userRepository: userRepository,
+		loginGuard:     loginGuard,
+		auditor:        auditor,
+	}
+}
+
+func (u *UnlockUser) Execute(ctx context.Context, req dto.UnlockUserRequest) error {
+	if err := application.ValidateDTO(req); err != nil {
+		return err
+	}
+
+	if err := req.Actor.Authorize(user.PermissionUsersWrite); err != nil {
+		return err
+	}
+
+	userId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return user.ErrUserNotFound
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if userModel == nil {
+		return user.ErrUserNotFound
+	}
+
+	if err := u.loginGuard.Unlock(ctx, userModel); err != nil {
+		return err
+	}
+
+	u.auditor.Record(ctx, newAuditEvent(audit.ActionUserUnlock, req.Actor.ID, userModel.ID, req.Actor.Client))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/cohort"
+)
+
+type UpdateAssignment struct {
+	assignmentRepository assignment.Repository
+	cohortRepository     cohort.Repository
+}
+
+func NewUpdateAssignment(assignmentRepository assignment.Repository, cohortRepository cohort.Repository) *UpdateAssignment {
+	return &UpdateAssignment{
+		assignmentRepository: assignmentRepository,
+		cohortRepository:     cohortRepository,
+	}
+}
+
+func (u *UpdateAssignment) Execute(ctx context.Context, req dto.UpdateAssignmentRequest) (*dto.AssignmentResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	assignmentModel, err := findManagedAssignment(ctx, u.assignmentRepository, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The validator has already checked the format.
+	dueAt, _ := time.Parse(time.RFC3339, req.DueAt)
+
+	if err := assignmentModel.Update(req.Title, dueAt, req.TargetPercent); err != nil {
+		return nil, err
+	}
+
+	if err := u.assignmentRepository.Update(ctx, assignmentModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewAssignmentResponse(assignmentModel), nil
+}
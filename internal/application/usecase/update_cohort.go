@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/cohort"
+)
+
+type UpdateCohort struct {
+	cohortRepository cohort.Repository
+}
+
+func NewUpdateCohort(cohortRepository cohort.Repository) *UpdateCohort {
+	return &UpdateCohort{
+		cohortRepository: cohortRepository,
+	}
+}
+
+func (u *UpdateCohort) Execute(ctx context.Context, req dto.UpdateCohortRequest) (*dto.CohortResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	cohortModel, err := findManagedCohort(ctx, u.cohortRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cohortModel.Update(req.Name, req.Description); err != nil {
+		return nil, err
+	}
+
+	if err := u.cohortRepository.Update(ctx, cohortModel); err != nil {
+		return nil, err
+	}
+
+	members, err := u.cohortRepository.Members(ctx, cohortModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCohortResponse(cohortModel, len(members), members), nil
+}
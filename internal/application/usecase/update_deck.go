@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+)
+
+type UpdateDeck struct {
+	deckRepository deck.Repository
+}
+
+func NewUpdateDeck(deckRepository deck.Repository) *UpdateDeck {
+	return &UpdateDeck{
+		deckRepository: deckRepository,
+	}
+}
+
+func (u *UpdateDeck) Execute(ctx context.Context, req dto.UpdateDeckRequest) (*dto.DeckResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deckModel.Update(req.Title, req.Description); err != nil {
+		return nil, err
+	}
+
+	if err := u.deckRepository.Update(ctx, deckModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewDeckResponse(deckModel), nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// UpdateProfile edits the name and profile of the caller. Email and password
+// have their own use cases because they need the current password.
+type UpdateProfile struct {
+	userService    *user.Service
+	userRepository user.Repository
+	auditor        application.Auditor
+}
+
+func NewUpdateProfile(userService *user.Service, userRepository user.Repository, auditor application.Auditor) *UpdateProfile {
+	return &UpdateProfile{
+		userService:    userService,
+		userRepository: userRepository,
+		auditor:        auditor,
+	}
+}
+
+func (u *UpdateProfile) Execute(ctx context.Context, req dto.UpdateProfileRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.IfMatch) > 0 && !slices.Contains(req.IfMatch, userModel.Version) {
+		return nil, user.ErrPreconditionFailed
+	}
+
+	before := userSnapshot(userModel)
+
+	if err := u.userService.UpdateUser(userModel, req.FirstName, req.LastName, "", ""); err != nil {
+		return nil, err
+	}
+
+	err = u.userService.UpdateProfileDetails(userModel, user.ProfileChanges{
+		DisplayName: req.DisplayName,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+		Bio:         req.Bio,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	event := newAuditEvent(audit.ActionUserUpdate, userModel.ID, userModel.ID, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel))
+	if event.HasChanges() {
+		u.auditor.Record(ctx, event)
+	}
+
+	return dto.NewUserResponse(userModel), nil
+}
+
+// findActorUser loads the account of an authenticated caller.
+func findActorUser(ctx context.Context, repo user.Repository, actor user.Actor) (*user.User, error) {
+	if actor.ID == uuid.Nil {
+		return nil, user.ErrAccessDenied
+	}
+
+	userModel, err := repo.FindByID(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	return userModel, nil
+}
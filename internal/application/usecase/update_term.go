@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/deck"
+
+	"github.com/google/uuid"
+)
+
+type UpdateTerm struct {
+	deckRepository deck.Repository
+}
+
+func NewUpdateTerm(deckRepository deck.Repository) *UpdateTerm {
+	return &UpdateTerm{
+		deckRepository: deckRepository,
+	}
+}
+
+// Execute replaces the content of a term, keeping its ID.
+func (u *UpdateTerm) Execute(ctx context.Context, req dto.UpdateTermRequest) (*dto.TermResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	termId, err := uuid.Parse(req.TermId)
+	if err != nil {
+		return nil, deck.ErrTermNotFound
+	}
+
+	deckModel, err := findEditableDeck(ctx, u.deckRepository, req.Actor, req.DeckId)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := deckModel.UpdateTerm(termId, req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.deckRepository.Update(ctx, deckModel); err != nil {
+		return nil, err
+	}
+
+	return dto.NewTermResponse(term), nil
+}
@@ -2,23 +2,40 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"log"
+	"slices"
 	"trainer/internal/application"
 	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
 	"trainer/internal/domain/user"
 
 	"github.com/google/uuid"
 )
 
+// UpdateUser edits an account through user management. Users change their
+// own password and email through the /me endpoints, which confirm the
+// current password.
 type UpdateUser struct {
-	userService    *user.Service
-	userRepository user.Repository
+	userService        *user.Service
+	userRepository     user.Repository
+	verificationMailer *VerificationMailer
+	denylist           application.TokenDenylist
+	auditor            application.Auditor
 }
 
-func NewUpdateUser(userService *user.Service, userRepository user.Repository) *UpdateUser {
+func NewUpdateUser(
+	userService *user.Service,
+	userRepository user.Repository,
+	verificationMailer *VerificationMailer,
+	denylist application.TokenDenylist,
+	auditor application.Auditor,
+) *UpdateUser {
 	return &UpdateUser{
-		userService:    userService,
-		userRepository: userRepository,
+		userService:        userService,
+		userRepository:     userRepository,
+		verificationMailer: verificationMailer,
+		denylist:           denylist,
+		auditor:            auditor,
 	}
 }
 
@@ -29,6 +46,10 @@ func (u *UpdateUser) Execute(ctx context.Context, req dto.UpdateUserRequest) (*d
 
 	userId, err := uuid.Parse(req.Id)
 	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+
+	if err := req.Actor.AuthorizeUser(userId, user.PermissionUsersWrite); err != nil {
 		return nil, err
 	}
 
@@ -39,20 +60,56 @@ func (u *UpdateUser) Execute(ctx context.Context, req dto.UpdateUserRequest) (*d
 	}
 
 	if userModel == nil {
-		return nil, errors.New("Not existed user")
+		return nil, user.ErrUserNotFound
 	}
 
+	if len(req.IfMatch) > 0 && !slices.Contains(req.IfMatch, userModel.Version) {
+		return nil, user.ErrPreconditionFailed
+	}
+
+	emailChanged := req.Email != "" && req.Email != userModel.Email
+	if req.Actor.ID == userModel.ID && (emailChanged || req.Password != "") {
+		return nil, user.ErrUseAccountEndpoint
+	}
+
+	before := userSnapshot(userModel)
+
 	err = u.userService.UpdateUser(userModel, req.FirstName, req.LastName, req.Email, req.Password)
 
 	if err != nil {
 		return nil, err
 	}
 
-	err = u.userRepository.Save(ctx, userModel)
+	// A password set by someone else ends every session, as a reset does.
+	if req.Password != "" {
+		u.userService.RevokeAllSessions(ctx, userModel)
+	}
+
+	err = u.userRepository.Update(ctx, userModel)
 
 	if err != nil {
 		return nil, err
 	}
 
+	event := newAuditEvent(audit.ActionUserUpdate, req.Actor.ID, userModel.ID, req.Actor.Client).
+		SetChanges(before, userSnapshot(userModel))
+	if event.HasChanges() {
+		u.auditor.Record(ctx, event)
+	}
+	if req.Password != "" {
+		u.auditor.Record(ctx, newAuditEvent(audit.ActionPasswordChange, req.Actor.ID, userModel.ID, req.Actor.Client).
+			WithMetadata("sessions_revoked", len(userModel.EndedSessions())))
+	}
+
+	if err := revokeEndedSessions(ctx, u.denylist, userModel); err != nil {
+		return nil, err
+	}
+
+	if emailChanged {
+		if err := u.verificationMailer.Send(ctx, userModel); err != nil {
+			log.Printf("send verification email to %s: %v", userModel.Email, err)
+		}
+	}
+
 	return dto.NewUserResponse(userModel), nil
 }
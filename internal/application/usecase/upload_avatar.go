@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// avatarExtensions lists the accepted image types.
+var avatarExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type UploadAvatar struct {
+	userService    *user.Service
+	userRepository user.Repository
+	storage        application.FileStorage
+}
+
+func NewUploadAvatar(userService *user.Service, userRepository user.Repository, storage application.FileStorage) *UploadAvatar {
+	return &UploadAvatar{
+		userService:    userService,
+		userRepository: userRepository,
+		storage:        storage,
+	}
+}
+
+// Execute stores the image under a fresh key, so caches never serve the old
+// avatar, and then removes the replaced file.
+func (u *UploadAvatar) Execute(ctx context.Context, req dto.UploadAvatarRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	ext, ok := avatarExtensions[req.ContentType]
+	if !ok {
+		return nil, user.ErrInvalidAvatar
+	}
+
+	userModel, err := findActorUser(ctx, u.userRepository, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("avatars/%s/%s%s", userModel.ID, uuid.New(), ext)
+	url, err := u.storage.Save(ctx, key, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := u.userService.SetAvatar(userModel, key, url)
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		deleteStoredFile(ctx, u.storage, key)
+		return nil, err
+	}
+
+	if previous != "" {
+		deleteStoredFile(ctx, u.storage, previous)
+	}
+
+	return dto.NewUserResponse(userModel), nil
+}
+
+// deleteStoredFile removes a file nothing refers to any more. A failure only
+// leaves an orphaned file, so it is logged.
+func deleteStoredFile(ctx context.Context, storage application.FileStorage, key string) {
+	if err := storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+		log.Printf("delete stored file %s: %v", key, err)
+	}
+}
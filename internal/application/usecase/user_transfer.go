@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// maxImportRows bounds one import; every row is hashed with bcrypt while
+// the request is open.
+const maxImportRows = 1000
+
+var importColumns = map[string]func(row *dto.ImportUserRow, value string){
+	"email":      func(row *dto.ImportUserRow, v string) { row.Email = v },
+	"first_name": func(row *dto.ImportUserRow, v string) { row.FirstName = v },
+	"last_name":  func(row *dto.ImportUserRow, v string) { row.LastName = v },
+	"password":   func(row *dto.ImportUserRow, v string) { row.Password = v },
+	"role":       func(row *dto.ImportUserRow, v string) { row.Role = v },
+}
+
+func decodeImportRows(r io.Reader, format string) ([]dto.ImportUserRow, error) {
+	var rows []dto.ImportUserRow
+	var err error
+	if format == dto.FormatCSV {
+		rows, err = decodeCSVRows(r)
+	} else {
+		rows, err = decodeJSONRows(r)
+	}
+
+	if errors.Is(err, application.ErrImportTooLarge) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", application.ErrInvalidImport, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rows", application.ErrInvalidImport)
+	}
+
+	for i := range rows {
+		rows[i].Email = strings.TrimSpace(rows[i].Email)
+		rows[i].FirstName = strings.TrimSpace(rows[i].FirstName)
+		rows[i].LastName = strings.TrimSpace(rows[i].LastName)
+		rows[i].Role = strings.TrimSpace(rows[i].Role)
+	}
+
+	return rows, nil
+}
+
+func decodeCSVRows(r io.Reader) ([]dto.ImportUserRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	setters := make([]func(*dto.ImportUserRow, string), len(header))
+	for i, name := range header {
+		// Spreadsheet tools often prepend a byte order mark.
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		setter, ok := importColumns[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		setters[i] = setter
+	}
+
+	var rows []dto.ImportUserRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("%w: at most %d rows", application.ErrImportTooLarge, maxImportRows)
+		}
+
+		var row dto.ImportUserRow
+		for i, value := range record {
+			setters[i](&row, value)
+		}
+		rows = append(rows, row)
+	}
+}
+
+// decodeJSONRows reads an array of rows element by element, so an oversized
+// file is rejected without decoding all of it.
+func decodeJSONRows(r io.Reader) ([]dto.ImportUserRow, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return nil, errors.New("expected a JSON array of users")
+	}
+
+	var rows []dto.ImportUserRow
+	for decoder.More() {
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("%w: at most %d rows", application.ErrImportTooLarge, maxImportRows)
+		}
+
+		var row dto.ImportUserRow
+		if err := decoder.Decode(&row); err != nil {
+			return nil, fmt.Errorf("row %d: %w", len(rows)+1, err)
+		}
+		rows = append(rows, row)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+type userEncoder interface {
+	encode(row dto.ExportUserRow) error
+	// flush writes out what has been encoded so far.
+	flush() error
+	// close ends the document.
+	close() error
+}
+
+func newUserEncoder(w io.Writer, format string) userEncoder {
+	if format == dto.FormatCSV {
+		return &csvUserEncoder{w: csv.NewWriter(w)}
+	}
+	return &jsonUserEncoder{w: w}
+}
+
+var exportColumns = []string{"id", "email", "first_name", "last_name", "role", "email_verified", "created_at"}
+
+type csvUserEncoder struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvUserEncoder) encode(row dto.ExportUserRow) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	return e.w.Write([]string{
+		row.ID,
+		spreadsheetSafe(row.Email),
+		spreadsheetSafe(row.FirstName),
+		spreadsheetSafe(row.LastName),
+		row.Role,
+		strconv.FormatBool(row.EmailVerified),
+		row.CreatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (e *csvUserEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.w.Write(exportColumns)
+}
+
+func (e *csvUserEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvUserEncoder) close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	return e.flush()
+}
+
+// jsonUserEncoder streams a JSON array, one element per user.
+type jsonUserEncoder struct {
+	w     io.Writer
+	buf   []byte
+	count int
+}
+
+func (e *jsonUserEncoder) encode(row dto.ExportUserRow) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+
+	if e.count == 0 {
+		e.buf = append(e.buf, '[')
+	} else {
+		e.buf = append(e.buf, ',')
+	}
+	e.buf = append(e.buf, '\n')
+	e.buf = append(e.buf, data...)
+	e.count++
+
+	return nil
+}
+
+func (e *jsonUserEncoder) flush() error {
+	if len(e.buf) == 0 {
+		return nil
+	}
+
+	_, err := e.w.Write(e.buf)
+	e.buf = e.buf[:0]
+	return err
+}
+
+func (e *jsonUserEncoder) close() error {
+	if e.count == 0 {
+		e.buf = append(e.buf, "[]\n"...)
+	} else {
+		e.buf = append(e.buf, "\n]\n"...)
+	}
+	return e.flush()
+}
+
+// newImportRowError describes why the row at index was rejected.
+func newImportRowError(index int, row dto.ImportUserRow, err error) dto.ImportRowError {
+	rowErr := dto.ImportRowError{Row: index + 1, Email: row.Email, Code: err.Error()}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		rowErr.Code = application.CodeValidationFailed
+		for _, fe := range validationErrs {
+			rowErr.Fields = append(rowErr.Fields, dto.ImportFieldErr{Field: fe.Field(), Rule: fe.Tag()})
+		}
+	}
+
+	return rowErr
+}
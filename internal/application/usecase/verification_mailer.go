@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"trainer/internal/application"
+	"trainer/internal/domain/user"
+)
+
+// VerificationMailer issues an email verification token for a user and sends
+// the confirmation link. It is shared by the use cases that need to
+// (re)verify an address.
+type VerificationMailer struct {
+	userService            *user.Service
+	verificationRepository user.EmailVerificationRepository
+	mailer                 application.Mailer
+	appURL                 string
+}
+
+func NewVerificationMailer(
+	userService *user.Service,
+	verificationRepository user.EmailVerificationRepository,
+	mailer application.Mailer,
+	appURL string,
+) *VerificationMailer {
+	return &VerificationMailer{
+		userService:            userService,
+		verificationRepository: verificationRepository,
+		mailer:                 mailer,
+		appURL:                 appURL,
+	}
+}
+
+func (v *VerificationMailer) Send(ctx context.Context, u *user.User) error {
+	token, secret, err := v.userService.RequestEmailVerification(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if err := v.verificationRepository.Save(ctx, token); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/email/verify?token=%s", v.appURL, url.QueryEscape(secret))
+
+	return v.mailer.Send(ctx, application.Message{
+		To:      token.Email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf(
+			"Open the link below to confirm your email address:\n%s\n\nThe link expires at %s.",
+			link, token.ExpiresAt.Format("2006-01-02 15:04 MST"),
+		),
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"trainer/internal/application"
+	"trainer/internal/application/dto"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/user"
+)
+
+type VerifyEmail struct {
+	userService            *user.Service
+	userRepository         user.Repository
+	verificationRepository user.EmailVerificationRepository
+	auditor                application.Auditor
+}
+
+func NewVerifyEmail(
+	userService *user.Service,
+	userRepository user.Repository,
+	verificationRepository user.EmailVerificationRepository,
+	auditor application.Auditor,
+) *VerifyEmail {
+	return &VerifyEmail{
+		userService:            userService,
+		userRepository:         userRepository,
+		verificationRepository: verificationRepository,
+		auditor:                auditor,
+	}
+}
+
+// Execute confirms the address the link was sent to. For a pending email
+// change this is when the account moves to the new address.
+func (u *VerifyEmail) Execute(ctx context.Context, req dto.VerifyEmailRequest) (*dto.UserResponse, error) {
+	if err := application.ValidateDTO(req); err != nil {
+		return nil, err
+	}
+
+	token, err := u.verificationRepository.FindByHash(ctx, user.HashSecret(req.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, user.ErrInvalidVerification
+	}
+
+	userModel, err := u.userRepository.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userModel == nil {
+		return nil, user.ErrInvalidVerification
+	}
+
+	oldEmail := userModel.Email
+	before := userSnapshot(userModel)
+
+	err = u.userService.VerifyEmail(ctx, userModel, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.verificationRepository.Consume(ctx, token); err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepository.Update(ctx, userModel); err != nil {
+		return nil, err
+	}
+
+	if userModel.Email != oldEmail {
+		u.auditor.Record(ctx, newAuditEvent(audit.ActionUserUpdate, userModel.ID, userModel.ID, user.ClientInfo{}).
+			SetChanges(before, userSnapshot(userModel)))
+	}
+
+	return dto.NewUserResponse(userModel), nil
+}
@@ -1 +1,446 @@
+// Package config loads and validates application configuration from the
+// environment and an optional YAML/JSON file
 package config
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"trainer/internal/domain/review"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/database"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const fileEnv = "CONFIG_FILE"
+
+type Config struct {
+	Database      database.Config
+	JWT           JWTConfig
+	HTTP          HTTPConfig
+	CORS          CORSConfig
+	Password      PasswordConfig
+	RefreshToken  RefreshTokenConfig
+	PasswordReset PasswordResetConfig
+	Verification  VerificationConfig
+	Lockout       LockoutConfig
+	MFA           MFAConfig
+	Deletion      DeletionConfig
+	Mail          MailConfig
+	Storage       StorageConfig
+	Review        ReviewConfig
+	App           AppConfig
+}
+
+// JWTConfig selects how access tokens are signed. When KeysDir is set, the
+// PEM keys in it (named <kid>.pem) replace the shared HS256 Secret.
+type JWTConfig struct {
+	Secret      string
+	TTL         time.Duration
+	KeysDir     string
+	ActiveKeyID string
+	Issuer      string
+	Audience    string
+	// DenylistSync bounds how long a revocation made on another instance
+	// takes to be enforced here.
+	DenylistSync time.Duration
+}
+
+type HTTPConfig struct {
+	Port            string
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+	// TrustedProxies lists the reverse proxies whose X-Real-IP header names
+	// the client. Requests from anywhere else are identified by their own
+	// address.
+	TrustedProxies []netip.Prefix
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string
+}
+
+type PasswordConfig struct {
+	BcryptCost int
+}
+
+type RefreshTokenConfig struct {
+	TTL time.Duration
+}
+
+type PasswordResetConfig struct {
+	TTL time.Duration
+}
+
+type VerificationConfig struct {
+	TTL    time.Duration
+	Policy user.VerificationPolicy
+}
+
+type LockoutConfig struct {
+	// Store is either "postgres" or "memory".
+	Store              string
+	FreeAttempts       int
+	BaseDelay          time.Duration
+	MaxAccountFailures int
+	MaxIPFailures      int
+	Duration           time.Duration
+}
+
+type MFAConfig struct {
+	Issuer        string
+	ChallengeTTL  time.Duration
+	RequiredRoles []string
+}
+
+// DeletionConfig controls soft-deleted accounts: they can be restored for
+// Retention and are erased by a purge that runs every PurgeInterval.
+type DeletionConfig struct {
+	Retention     time.Duration
+	PurgeInterval time.Duration
+}
+
+// MailConfig configures the file mailer; an empty Dir logs messages instead.
+type MailConfig struct {
+	From string
+	Dir  string
+}
+
+// StorageConfig configures the local file storage for uploads. Files are
+// written to Dir and linked under URL, which the API serves itself when it
+// is a path; point it elsewhere when a proxy or CDN serves Dir.
+type StorageConfig struct {
+	Dir string
+	URL string
+}
+
+// ReviewConfig selects the spaced-repetition algorithm. DesiredRetention is
+// the recall probability FSRS schedules for; SM-2 ignores it.
+type ReviewConfig struct {
+	Algorithm        string
+	DesiredRetention float64
+	MaxIntervalDays  int
+}
+
+type AppConfig struct {
+	// URL is the public address of the frontend, used to build email links.
+	URL string
+}
+
+func Default() *Config {
+	return &Config{
+		Database: *database.DefaultConfig(),
+		JWT: JWTConfig{
+			TTL:          15 * time.Minute,
+			Issuer:       "trainer",
+			Audience:     "trainer-api",
+			DenylistSync: 10 * time.Second,
+		},
+		HTTP: HTTPConfig{
+			Port:            "8080",
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    15 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"*"},
+		},
+		Password: PasswordConfig{
+			BcryptCost: bcrypt.DefaultCost,
+		},
+		RefreshToken: RefreshTokenConfig{
+			TTL: 30 * 24 * time.Hour,
+		},
+		PasswordReset: PasswordResetConfig{
+			TTL: time.Hour,
+		},
+		Verification: VerificationConfig{
+			TTL:    48 * time.Hour,
+			Policy: user.VerificationRequired,
+		},
+		Lockout: LockoutConfig{
+			Store:              "postgres",
+			FreeAttempts:       3,
+			BaseDelay:          time.Second,
+			MaxAccountFailures: 10,
+			MaxIPFailures:      100,
+			Duration:           15 * time.Minute,
+		},
+		MFA: MFAConfig{
+			Issuer:        "Trainer",
+			ChallengeTTL:  5 * time.Minute,
+			RequiredRoles: []string{string(user.RoleAdmin)},
+		},
+		Deletion: DeletionConfig{
+			Retention:     30 * 24 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+		Mail: MailConfig{
+			From: "no-reply@trainer.local",
+		},
+		Storage: StorageConfig{
+			Dir: "storage",
+			URL: "/media",
+		},
+		Review: ReviewConfig{
+			Algorithm:        review.AlgorithmFSRS,
+			DesiredRetention: 0.9,
+			MaxIntervalDays:  365 * 10,
+		},
+		App: AppConfig{
+			URL: "http://localhost:30001",
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the file referenced by
+// CONFIG_FILE (if any) and the environment, in increasing priority.
+func Load() (*Config, error) {
+	l, err := newLoader(os.Getenv(fileEnv))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+	l.database(&cfg.Database)
+
+	l.str("JWT_SECRET", "jwt.secret", &cfg.JWT.Secret)
+	l.minutes("JWT_DURATION_IN_MINUTE", "jwt.duration_in_minute", &cfg.JWT.TTL)
+	l.str("JWT_KEYS_DIR", "jwt.keys_dir", &cfg.JWT.KeysDir)
+	l.str("JWT_ACTIVE_KEY_ID", "jwt.active_key_id", &cfg.JWT.ActiveKeyID)
+	l.str("JWT_ISSUER", "jwt.issuer", &cfg.JWT.Issuer)
+	l.str("JWT_AUDIENCE", "jwt.audience", &cfg.JWT.Audience)
+	l.duration("JWT_DENYLIST_SYNC_INTERVAL", "jwt.denylist_sync_interval", &cfg.JWT.DenylistSync)
+
+	l.str("PORT", "http.port", &cfg.HTTP.Port)
+	l.duration("HTTP_READ_TIMEOUT", "http.read_timeout", &cfg.HTTP.ReadTimeout)
+	l.duration("HTTP_WRITE_TIMEOUT", "http.write_timeout", &cfg.HTTP.WriteTimeout)
+	l.duration("HTTP_IDLE_TIMEOUT", "http.idle_timeout", &cfg.HTTP.IdleTimeout)
+	l.duration("HTTP_SHUTDOWN_TIMEOUT", "http.shutdown_timeout", &cfg.HTTP.ShutdownTimeout)
+	l.prefixes("HTTP_TRUSTED_PROXIES", "http.trusted_proxies", &cfg.HTTP.TrustedProxies)
+
+	l.list("CORS_ALLOWED_ORIGINS", "cors.allowed_origins", &cfg.CORS.AllowedOrigins)
+
+	l.integer("BCRYPT_COST", "password.bcrypt_cost", &cfg.Password.BcryptCost)
+
+	l.duration("REFRESH_TOKEN_TTL", "refresh_token.ttl", &cfg.RefreshToken.TTL)
+
+	l.duration("PASSWORD_RESET_TTL", "password_reset.ttl", &cfg.PasswordReset.TTL)
+
+	l.duration("EMAIL_VERIFICATION_TTL", "verification.ttl", &cfg.Verification.TTL)
+	l.str("EMAIL_VERIFICATION_POLICY", "verification.policy", (*string)(&cfg.Verification.Policy))
+
+	l.str("LOGIN_ATTEMPT_STORE", "lockout.store", &cfg.Lockout.Store)
+	l.integer("LOGIN_FREE_ATTEMPTS", "lockout.free_attempts", &cfg.Lockout.FreeAttempts)
+	l.duration("LOGIN_BASE_DELAY", "lockout.base_delay", &cfg.Lockout.BaseDelay)
+	l.integer("LOGIN_MAX_ACCOUNT_FAILURES", "lockout.max_account_failures", &cfg.Lockout.MaxAccountFailures)
+	l.integer("LOGIN_MAX_IP_FAILURES", "lockout.max_ip_failures", &cfg.Lockout.MaxIPFailures)
+	l.duration("LOGIN_LOCKOUT_DURATION", "lockout.duration", &cfg.Lockout.Duration)
+
+	l.str("MFA_ISSUER", "mfa.issuer", &cfg.MFA.Issuer)
+	l.duration("MFA_CHALLENGE_TTL", "mfa.challenge_ttl", &cfg.MFA.ChallengeTTL)
+	l.list("MFA_REQUIRED_ROLES", "mfa.required_roles", &cfg.MFA.RequiredRoles)
+
+	l.duration("USER_DELETION_RETENTION", "deletion.retention", &cfg.Deletion.Retention)
+	l.duration("USER_PURGE_INTERVAL", "deletion.purge_interval", &cfg.Deletion.PurgeInterval)
+
+	l.str("MAIL_FROM", "mail.from", &cfg.Mail.From)
+	l.str("MAIL_DIR", "mail.dir", &cfg.Mail.Dir)
+
+	l.str("STORAGE_DIR", "storage.dir", &cfg.Storage.Dir)
+	l.str("STORAGE_URL", "storage.url", &cfg.Storage.URL)
+	cfg.Storage.URL = strings.TrimRight(cfg.Storage.URL, "/")
+
+	l.str("REVIEW_ALGORITHM", "review.algorithm", &cfg.Review.Algorithm)
+	l.float("REVIEW_DESIRED_RETENTION", "review.desired_retention", &cfg.Review.DesiredRetention)
+	l.integer("REVIEW_MAX_INTERVAL_DAYS", "review.max_interval_days", &cfg.Review.MaxIntervalDays)
+
+	l.str("APP_URL", "app.url", &cfg.App.URL)
+	cfg.App.URL = strings.TrimRight(cfg.App.URL, "/")
+
+	if err := joinErrors(append(l.errs, cfg.validate()...)); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// LoadDatabase loads only the database section, for tools such as the
+// migrator that do not need the rest of the application configuration.
+func LoadDatabase() (*database.Config, error) {
+	l, err := newLoader(os.Getenv(fileEnv))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := database.DefaultConfig()
+	l.database(cfg)
+
+	if err := joinErrors(append(l.errs, validateDatabase(cfg)...)); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) Validate() error {
+	return joinErrors(c.validate())
+}
+
+func (c *Config) validate() []error {
+	errs := validateDatabase(&c.Database)
+
+	if c.JWT.KeysDir == "" && c.JWT.Secret == "" {
+		errs = append(errs, errors.New("JWT_SECRET is required unless JWT_KEYS_DIR is set"))
+	}
+	if c.JWT.KeysDir != "" && c.JWT.ActiveKeyID == "" {
+		errs = append(errs, errors.New("JWT_ACTIVE_KEY_ID is required when JWT_KEYS_DIR is set"))
+	}
+	if c.JWT.Issuer == "" {
+		errs = append(errs, errors.New("JWT_ISSUER is required"))
+	}
+	if c.JWT.Audience == "" {
+		errs = append(errs, errors.New("JWT_AUDIENCE is required"))
+	}
+	if c.JWT.DenylistSync <= 0 {
+		errs = append(errs, errors.New("JWT_DENYLIST_SYNC_INTERVAL must be positive"))
+	}
+	if c.JWT.TTL <= 0 {
+		errs = append(errs, errors.New("JWT_DURATION_IN_MINUTE must be positive"))
+	}
+
+	if port, err := strconv.Atoi(c.HTTP.Port); err != nil || port <= 0 || port > 65535 {
+		errs = append(errs, fmt.Errorf("PORT must be a number between 1 and 65535, got %q", c.HTTP.Port))
+	}
+	if c.HTTP.ReadTimeout <= 0 {
+		errs = append(errs, errors.New("HTTP_READ_TIMEOUT must be positive"))
+	}
+	if c.HTTP.WriteTimeout <= 0 {
+		errs = append(errs, errors.New("HTTP_WRITE_TIMEOUT must be positive"))
+	}
+	if c.HTTP.IdleTimeout <= 0 {
+		errs = append(errs, errors.New("HTTP_IDLE_TIMEOUT must be positive"))
+	}
+	if c.HTTP.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("HTTP_SHUTDOWN_TIMEOUT must be positive"))
+	}
+
+	if len(c.CORS.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("CORS_ALLOWED_ORIGINS must not be empty"))
+	}
+
+	if c.Password.BcryptCost < bcrypt.MinCost || c.Password.BcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("BCRYPT_COST must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost))
+	}
+
+	if c.RefreshToken.TTL <= 0 {
+		errs = append(errs, errors.New("REFRESH_TOKEN_TTL must be positive"))
+	}
+
+	if c.PasswordReset.TTL <= 0 {
+		errs = append(errs, errors.New("PASSWORD_RESET_TTL must be positive"))
+	}
+
+	if c.Verification.TTL <= 0 {
+		errs = append(errs, errors.New("EMAIL_VERIFICATION_TTL must be positive"))
+	}
+	if !c.Verification.Policy.IsValid() {
+		errs = append(errs, fmt.Errorf("EMAIL_VERIFICATION_POLICY must be one of off, required, restricted, got %q", c.Verification.Policy))
+	}
+
+	if c.Lockout.Store != "postgres" && c.Lockout.Store != "memory" {
+		errs = append(errs, fmt.Errorf("LOGIN_ATTEMPT_STORE must be postgres or memory, got %q", c.Lockout.Store))
+	}
+	if c.Lockout.FreeAttempts < 0 {
+		errs = append(errs, errors.New("LOGIN_FREE_ATTEMPTS must not be negative"))
+	}
+	if c.Lockout.BaseDelay < 0 {
+		errs = append(errs, errors.New("LOGIN_BASE_DELAY must not be negative"))
+	}
+	if c.Lockout.MaxAccountFailures <= c.Lockout.FreeAttempts {
+		errs = append(errs, errors.New("LOGIN_MAX_ACCOUNT_FAILURES must be greater than LOGIN_FREE_ATTEMPTS"))
+	}
+	if c.Lockout.MaxIPFailures <= c.Lockout.FreeAttempts {
+		errs = append(errs, errors.New("LOGIN_MAX_IP_FAILURES must be greater than LOGIN_FREE_ATTEMPTS"))
+	}
+	if c.Lockout.Duration <= 0 {
+		errs = append(errs, errors.New("LOGIN_LOCKOUT_DURATION must be positive"))
+	}
+
+	if c.MFA.Issuer == "" {
+		errs = append(errs, errors.New("MFA_ISSUER is required"))
+	}
+	if c.MFA.ChallengeTTL <= 0 {
+		errs = append(errs, errors.New("MFA_CHALLENGE_TTL must be positive"))
+	}
+	for _, role := range c.MFA.RequiredRoles {
+		if !user.Role(role).IsValid() {
+			errs = append(errs, fmt.Errorf("MFA_REQUIRED_ROLES: unknown role %q", role))
+		}
+	}
+
+	if c.Deletion.Retention <= 0 {
+		errs = append(errs, errors.New("USER_DELETION_RETENTION must be positive"))
+	}
+	if c.Deletion.PurgeInterval <= 0 {
+		errs = append(errs, errors.New("USER_PURGE_INTERVAL must be positive"))
+	}
+
+	if c.Mail.From == "" {
+		errs = append(errs, errors.New("MAIL_FROM is required"))
+	}
+
+	if c.Storage.Dir == "" {
+		errs = append(errs, errors.New("STORAGE_DIR is required"))
+	}
+	if c.Storage.URL == "" {
+		errs = append(errs, errors.New("STORAGE_URL is required"))
+	}
+
+	if _, err := review.NewScheduler(c.Review.Algorithm, c.Review.DesiredRetention, c.Review.MaxIntervalDays); err != nil {
+		errs = append(errs, fmt.Errorf("REVIEW_ALGORITHM: unknown algorithm %q", c.Review.Algorithm))
+	}
+	if c.Review.DesiredRetention <= 0 || c.Review.DesiredRetention >= 1 {
+		errs = append(errs, errors.New("REVIEW_DESIRED_RETENTION must be between 0 and 1"))
+	}
+	if c.Review.MaxIntervalDays < 1 {
+		errs = append(errs, errors.New("REVIEW_MAX_INTERVAL_DAYS must be positive"))
+	}
+
+	if c.App.URL == "" {
+		errs = append(errs, errors.New("APP_URL is required"))
+	}
+
+	return errs
+}
+
+func joinErrors(errs []error) error {
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
+func validateDatabase(c *database.Config) []error {
+	var errs []error
+
+	if c.DSN == "" {
+		errs = append(errs, errors.New("DB_DSN is required"))
+	}
+	if c.MaxConns <= 0 {
+		errs = append(errs, errors.New("DB_MAX_CONNECTIONS must be positive"))
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		errs = append(errs, errors.New("DB_MIN_CONNECTIONS must be between 0 and DB_MAX_CONNECTIONS"))
+	}
+
+	return errs
+}
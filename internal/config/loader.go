@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"trainer/internal/infrastructure/database"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// loader resolves each setting from the environment first and the config
+// file second, collecting parse errors instead of stopping at the first one.
+type loader struct {
+	file map[string]string
+	errs []error
+}
+
+func newLoader(path string) (*loader, error) {
+	l := &loader{file: make(map[string]string)}
+	if path == "" {
+		return l, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	// JSON is a subset of YAML, so one decoder covers both formats.
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	flatten("", raw, l.file)
+
+	return l, nil
+}
+
+func flatten(prefix string, in map[string]any, out map[string]string) {
+	for k, v := range in {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+
+		switch value := v.(type) {
+		case map[string]any:
+			flatten(key, value, out)
+		case []any:
+			items := make([]string, len(value))
+			for i, item := range value {
+				items[i] = fmt.Sprint(item)
+			}
+			out[key] = strings.Join(items, ",")
+		case nil:
+		default:
+			out[key] = fmt.Sprint(value)
+		}
+	}
+}
+
+func (l *loader) lookup(env, key string) (string, bool) {
+	if v, ok := os.LookupEnv(env); ok {
+		return v, true
+	}
+
+	v, ok := l.file[key]
+	return v, ok
+}
+
+func (l *loader) fail(env, value string, err error) {
+	l.errs = append(l.errs, fmt.Errorf("%s: invalid value %q: %w", env, value, err))
+}
+
+func (l *loader) database(cfg *database.Config) {
+	l.str("DB_DSN", "database.dsn", &cfg.DSN)
+	l.int32("DB_MAX_CONNECTIONS", "database.max_connections", &cfg.MaxConns)
+	l.int32("DB_MIN_CONNECTIONS", "database.min_connections", &cfg.MinConns)
+	l.duration("DB_MAX_CONN_LIFETIME", "database.max_conn_lifetime", &cfg.MaxConnLifetime)
+	l.duration("DB_MAX_CONN_IDLE_TIME", "database.max_conn_idle_time", &cfg.MaxConnIdleTime)
+}
+
+func (l *loader) str(env, key string, dst *string) {
+	if v, ok := l.lookup(env, key); ok {
+		*dst = strings.TrimSpace(v)
+	}
+}
+
+func (l *loader) integer(env, key string, dst *int) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		l.fail(env, v, err)
+		return
+	}
+	*dst = n
+}
+
+func (l *loader) int32(env, key string, dst *int32) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+	if err != nil {
+		l.fail(env, v, err)
+		return
+	}
+	*dst = int32(n)
+}
+
+func (l *loader) float(env, key string, dst *float64) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil {
+		l.fail(env, v, err)
+		return
+	}
+	*dst = f
+}
+
+func (l *loader) duration(env, key string, dst *time.Duration) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(v))
+	if err != nil {
+		l.fail(env, v, err)
+		return
+	}
+	*dst = d
+}
+
+func (l *loader) minutes(env, key string, dst *time.Duration) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		l.fail(env, v, err)
+		return
+	}
+	*dst = time.Duration(n) * time.Minute
+}
+
+// prefixes reads a list of IP addresses and CIDR ranges.
+func (l *loader) prefixes(env, key string, dst *[]netip.Prefix) {
+	var items []string
+	l.list(env, key, &items)
+	if items == nil {
+		return
+	}
+
+	out := make([]netip.Prefix, 0, len(items))
+	for _, item := range items {
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(item)
+			if addrErr != nil {
+				l.fail(env, item, err)
+				continue
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		out = append(out, prefix.Masked())
+	}
+	*dst = out
+}
+
+func (l *loader) list(env, key string, dst *[]string) {
+	v, ok := l.lookup(env, key)
+	if !ok {
+		return
+	}
+
+	items := make([]string, 0)
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*dst = items
+}
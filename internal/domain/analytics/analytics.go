@@ -0,0 +1,114 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxRangeDays bounds a report so a single request cannot scan years of
+// reviews.
+const MaxRangeDays = 366
+
+// Range is a span of whole days in a time zone: From is the midnight that
+// starts the first day and To the midnight after the last one.
+type Range struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+}
+
+// NewRange covers the days from first to last, both included, as dates in
+// loc.
+func NewRange(first, last time.Time, loc *time.Location) (Range, error) {
+	from := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(last.Year(), last.Month(), last.Day()+1, 0, 0, 0, 0, loc)
+
+	if !to.After(from) || from.AddDate(0, 0, MaxRangeDays).Before(to) {
+		return Range{}, ErrInvalidRange
+	}
+
+	return Range{From: from, To: to, Location: loc}, nil
+}
+
+// Days lists the first instant of every day in the range.
+func (r Range) Days() []time.Time {
+	days := make([]time.Time, 0)
+	for day := r.From; day.Before(r.To); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// Totals adds up reviews. Recall reviews are those of terms the student had
+// already learned; Recalled counts the ones not graded Again.
+type Totals struct {
+	Reviews       int
+	RecallReviews int
+	Recalled      int
+	TimeSpent     time.Duration
+}
+
+// RetentionRate is the share of recall reviews the student got right; ok is
+// false when there were none.
+func (t Totals) RetentionRate() (rate float64, ok bool) {
+	if t.RecallReviews == 0 {
+		return 0, false
+	}
+	return float64(t.Recalled) / float64(t.RecallReviews), true
+}
+
+type Day struct {
+	Date time.Time
+	Totals
+	ActiveStudents int
+}
+
+type StudentSummary struct {
+	StudentID uuid.UUID
+	Totals
+	ActiveDays int
+	// Streaks count consecutive days with reviews over the whole history;
+	// the current one is still alive if it reached yesterday.
+	CurrentStreak  int
+	LongestStreak  int
+	LastReviewedAt *time.Time
+}
+
+// TermStats is how a term fared in the range.
+type TermStats struct {
+	TermID   uuid.UUID
+	DeckID   uuid.UUID
+	Front    string
+	Reviews  int
+	Failures int
+}
+
+func (t *TermStats) FailureRate() float64 {
+	if t.Reviews == 0 {
+		return 0
+	}
+	return float64(t.Failures) / float64(t.Reviews)
+}
+
+// FillDays returns one entry per day of the range, adding empty days where
+// days has none. days must be in date order.
+func FillDays(days []*Day, r Range) []*Day {
+	filled := make([]*Day, 0, len(days))
+	i := 0
+	for _, date := range r.Days() {
+		if i < len(days) && sameDate(days[i].Date, date) {
+			filled = append(filled, days[i])
+			i++
+			continue
+		}
+		filled = append(filled, &Day{Date: date})
+	}
+	return filled
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
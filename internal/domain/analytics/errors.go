@@ -0,0 +1,8 @@
+package analytics
+
+import "errors"
+
+var (
+	ErrInvalidRange    = errors.New("INVALID_RANGE")
+	ErrInvalidTimezone = errors.New("INVALID_TIMEZONE")
+)
@@ -0,0 +1,25 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository aggregates the review logs of a set of students.
+type Repository interface {
+	// Daily returns the totals of each day in the range that has reviews,
+	// in date order.
+	Daily(ctx context.Context, studentIDs []uuid.UUID, r Range) ([]*Day, error)
+
+	// Students returns a summary of each student for the range, in the order
+	// of studentIDs. today, a date in the range's location, anchors the
+	// current streak.
+	Students(ctx context.Context, studentIDs []uuid.UUID, r Range, today time.Time) ([]*StudentSummary, error)
+
+	// HardestTerms returns the terms failed at the highest rate in the range.
+	// ownerID limits them to the decks of one owner; uuid.Nil counts every
+	// deck.
+	HardestTerms(ctx context.Context, studentIDs []uuid.UUID, ownerID uuid.UUID, r Range, limit int) ([]*TermStats, error)
+}
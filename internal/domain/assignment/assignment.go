@@ -0,0 +1,129 @@
+package assignment
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxTitleLength = 200
+
+// MasteryIntervalDays is the review interval from which a term counts as
+// mastered: the scheduler expects the student to still recall it days later.
+const MasteryIntervalDays = 3
+
+type Status string
+
+const (
+	StatusNotStarted Status = "not_started"
+	StatusInProgress Status = "in_progress"
+	StatusCompleted  Status = "completed"
+	StatusOverdue    Status = "overdue"
+)
+
+// Assignment asks every student of a cohort to master a share of a deck's
+// terms by a deadline.
+type Assignment struct {
+	ID       uuid.UUID
+	CohortID uuid.UUID
+	DeckID   uuid.UUID
+	Title    string
+	DueAt    time.Time
+	// TargetPercent is the share of the deck's terms to master, 1 to 100.
+	TargetPercent int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Progress is how far a student is with the deck of an assignment.
+type Progress struct {
+	StudentID      uuid.UUID
+	TotalTerms     int
+	ReviewedTerms  int
+	MasteredTerms  int
+	LastReviewedAt *time.Time
+}
+
+func NewAssignment(cohortID, deckID uuid.UUID, title string, dueAt time.Time, targetPercent int) (*Assignment, error) {
+	now := time.Now()
+	a := &Assignment{
+		ID:        uuid.New(),
+		CohortID:  cohortID,
+		DeckID:    deckID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if !dueAt.After(now) {
+		return nil, ErrInvalidDueDate
+	}
+
+	if err := a.Update(title, dueAt, targetPercent); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func NewAssignmentFromStorage(id, cohortID, deckID uuid.UUID, title string, dueAt time.Time, targetPercent int, createdAt, updatedAt time.Time) *Assignment {
+	return &Assignment{
+		ID:            id,
+		CohortID:      cohortID,
+		DeckID:        deckID,
+		Title:         title,
+		DueAt:         dueAt,
+		TargetPercent: targetPercent,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// Update changes the assignment; a deadline already past is accepted so an
+// assignment can be closed early.
+func (a *Assignment) Update(title string, dueAt time.Time, targetPercent int) error {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLength {
+		return ErrInvalidTitle
+	}
+
+	if dueAt.IsZero() {
+		return ErrInvalidDueDate
+	}
+
+	if targetPercent < 1 || targetPercent > 100 {
+		return ErrInvalidTarget
+	}
+
+	a.Title = title
+	a.DueAt = dueAt
+	a.TargetPercent = targetPercent
+	a.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// StatusOf tells where a student stands. Reaching the target completes the
+// assignment even after the deadline.
+func (a *Assignment) StatusOf(p *Progress, now time.Time) Status {
+	switch {
+	case p.MasteredTerms*100 >= a.TargetPercent*p.TotalTerms:
+		return StatusCompleted
+	case now.After(a.DueAt):
+		return StatusOverdue
+	case p.ReviewedTerms == 0:
+		return StatusNotStarted
+	default:
+		return StatusInProgress
+	}
+}
+
+// MasteryPercent is the share of the deck's terms mastered; an empty deck is
+// fully mastered.
+func (p *Progress) MasteryPercent() float64 {
+	if p.TotalTerms == 0 {
+		return 100
+	}
+	return float64(p.MasteredTerms) * 100 / float64(p.TotalTerms)
+}
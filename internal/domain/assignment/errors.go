@@ -0,0 +1,10 @@
+package assignment
+
+import "errors"
+
+var (
+	ErrAssignmentNotFound = errors.New("ASSIGNMENT_NOT_FOUND")
+	ErrInvalidTitle       = errors.New("INVALID_ASSIGNMENT_TITLE")
+	ErrInvalidDueDate     = errors.New("INVALID_DUE_DATE")
+	ErrInvalidTarget      = errors.New("INVALID_TARGET")
+)
@@ -0,0 +1,39 @@
+package assignment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*Assignment, error)
+
+	// List returns assignments by deadline, earliest first.
+	List(ctx context.Context, query ListQuery) ([]*Assignment, error)
+
+	Save(ctx context.Context, assignment *Assignment) error
+
+	Update(ctx context.Context, assignment *Assignment) error
+
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AssignedDecks returns the decks assigned to any cohort the student is
+	// a member of.
+	AssignedDecks(ctx context.Context, studentID uuid.UUID) ([]uuid.UUID, error)
+
+	// Progress returns the progress of each student on the deck, in the
+	// order of studentIDs.
+	Progress(ctx context.Context, deckID uuid.UUID, studentIDs []uuid.UUID) ([]*Progress, error)
+
+	// StudentProgress returns the progress of one student on each deck, in
+	// the order of deckIDs.
+	StudentProgress(ctx context.Context, studentID uuid.UUID, deckIDs []uuid.UUID) ([]*Progress, error)
+}
+
+// ListQuery filters assignments; zero-valued filters are ignored.
+type ListQuery struct {
+	CohortID uuid.UUID
+	// StudentID limits the list to the cohorts the student is a member of.
+	StudentID uuid.UUID
+}
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"maps"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Action string
+
+const (
+	ActionLogin          Action = "auth.login"
+	ActionLoginFailed    Action = "auth.login_failed"
+	ActionMFAChallenge   Action = "auth.mfa_challenge"
+	ActionTokenRefresh   Action = "auth.token_refresh"
+	ActionTokenReuse     Action = "auth.token_reuse"
+	ActionUserCreate     Action = "user.create"
+	ActionUserUpdate     Action = "user.update"
+	ActionPasswordChange Action = "user.password_change"
+	ActionPasswordReset  Action = "user.password_reset"
+	ActionUserDelete     Action = "user.delete"
+	ActionUserErase      Action = "user.erase"
+	ActionUserRestore    Action = "user.restore"
+	ActionRoleChange     Action = "user.role_change"
+	ActionMFAEnable      Action = "user.mfa_enable"
+	ActionMFADisable     Action = "user.mfa_disable"
+	ActionUserUnlock     Action = "user.unlock"
+	ActionUserPurge      Action = "user.purge"
+)
+
+// Event records who did what to whom. ActorID is uuid.Nil for anonymous
+// requests such as failed logins, TargetID when the action has no subject.
+// Before and After hold only the fields the action changed.
+type Event struct {
+	ID         uuid.UUID
+	Action     Action
+	ActorID    uuid.UUID
+	TargetID   uuid.UUID
+	IP         string
+	UserAgent  string
+	Before     map[string]any
+	After      map[string]any
+	Metadata   map[string]any
+	OccurredAt time.Time
+}
+
+func NewEvent(action Action, actorID, targetID uuid.UUID, ip, userAgent string) *Event {
+	return &Event{
+		ID:         uuid.New(),
+		Action:     action,
+		ActorID:    actorID,
+		TargetID:   targetID,
+		IP:         ip,
+		UserAgent:  userAgent,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func NewEventFromStorage(
+	id uuid.UUID,
+	action Action,
+	actorID, targetID uuid.UUID,
+	ip, userAgent string,
+	before, after, metadata map[string]any,
+	occurredAt time.Time,
+) *Event {
+	return &Event{
+		ID:         id,
+		Action:     action,
+		ActorID:    actorID,
+		TargetID:   targetID,
+		IP:         ip,
+		UserAgent:  userAgent,
+		Before:     before,
+		After:      after,
+		Metadata:   metadata,
+		OccurredAt: occurredAt,
+	}
+}
+
+// SetChanges keeps the fields that differ between the two snapshots. Pass a
+// nil snapshot for a side that does not exist, as with creation.
+func (e *Event) SetChanges(before, after map[string]any) *Event {
+	e.Before, e.After = Diff(before, after)
+	return e
+}
+
+func (e *Event) WithMetadata(key string, value any) *Event {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]any)
+	}
+	e.Metadata[key] = value
+	return e
+}
+
+// HasChanges reports whether SetChanges found any difference.
+func (e *Event) HasChanges() bool {
+	return len(e.Before) > 0 || len(e.After) > 0
+}
+
+func Diff(before, after map[string]any) (map[string]any, map[string]any) {
+	changedBefore := make(map[string]any)
+	changedAfter := make(map[string]any)
+
+	keys := maps.Clone(before)
+	if keys == nil {
+		keys = make(map[string]any)
+	}
+	maps.Copy(keys, after)
+
+	for key := range keys {
+		valueBefore, inBefore := before[key]
+		valueAfter, inAfter := after[key]
+		if inBefore == inAfter && reflect.DeepEqual(valueBefore, valueAfter) {
+			continue
+		}
+		if inBefore {
+			changedBefore[key] = valueBefore
+		}
+		if inAfter {
+			changedAfter[key] = valueAfter
+		}
+	}
+
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("INVALID_CURSOR")
+
+// Query selects events newest first. Zero-valued filters are ignored.
+type Query struct {
+	Action   Action
+	ActorID  uuid.UUID
+	TargetID uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	After    *Cursor
+}
+
+type Page struct {
+	Events []*Event
+	Next   *Cursor
+}
+
+// Cursor points just past the last event of a page.
+type Cursor struct {
+	OccurredAt time.Time `json:"t"`
+	ID         uuid.UUID `json:"id"`
+}
+
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
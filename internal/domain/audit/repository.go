@@ -0,0 +1,18 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Save(ctx context.Context, event *Event) error
+
+	Find(ctx context.Context, query Query) (*Page, error)
+
+	// Redact removes the fields from the before and after state of every
+	// event targeting one of the users, so an erased user leaves no
+	// personal data in the trail.
+	Redact(ctx context.Context, targetIDs []uuid.UUID, fields []string) error
+}
@@ -0,0 +1,152 @@
+package cohort
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+	"trainer/internal/domain/user"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxNameLength        = 200
+	maxDescriptionLength = 2000
+
+	joinCodeLength = 8
+	// joinCodeAlphabet leaves out characters that are easy to confuse when
+	// a code is read aloud or copied by hand: 0/O and 1/I/L.
+	joinCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
+
+// Cohort is a group of students led by a mentor. Students join it with the
+// join code, which the mentor can also send them by email.
+type Cohort struct {
+	ID          uuid.UUID
+	MentorID    uuid.UUID
+	Name        string
+	Description string
+	JoinCode    string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Member struct {
+	CohortID  uuid.UUID
+	StudentID uuid.UUID
+	JoinedAt  time.Time
+}
+
+func NewCohort(mentorID uuid.UUID, name, description string) (*Cohort, error) {
+	now := time.Now()
+	c := &Cohort{
+		ID:        uuid.New(),
+		MentorID:  mentorID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := c.Update(name, description); err != nil {
+		return nil, err
+	}
+	if err := c.RotateJoinCode(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func NewCohortFromStorage(id, mentorID uuid.UUID, name, description, joinCode string, createdAt, updatedAt time.Time) *Cohort {
+	return &Cohort{
+		ID:          id,
+		MentorID:    mentorID,
+		Name:        name,
+		Description: description,
+		JoinCode:    joinCode,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// AuthorizeManage lets mentors manage their own cohorts and admins every
+// cohort.
+func (c *Cohort) AuthorizeManage(actor user.Actor) error {
+	if err := actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return err
+	}
+
+	if c.MentorID != actor.ID && !actor.Role.Can(user.PermissionCoursesAdmin) {
+		return user.ErrAccessDenied
+	}
+
+	return nil
+}
+
+func (c *Cohort) Update(name, description string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return ErrInvalidName
+	}
+
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return ErrInvalidDescription
+	}
+
+	c.Name = name
+	c.Description = description
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RotateJoinCode replaces the join code, so the old one no longer admits
+// anyone. Current members stay.
+func (c *Cohort) RotateJoinCode() error {
+	b := make([]byte, joinCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+
+	// 256 is not a multiple of the alphabet size; the slight bias does not
+	// matter for a code that only needs to be hard to guess in a few tries.
+	for i := range b {
+		b[i] = joinCodeAlphabet[int(b[i])%len(joinCodeAlphabet)]
+	}
+
+	c.JoinCode = string(b)
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// NewMember checks that the user can join a cohort: only students can.
+func NewMember(cohortID uuid.UUID, student *user.User) (*Member, error) {
+	if student.Role != user.RoleStudent || student.IsDeleted() {
+		return nil, ErrNotStudent
+	}
+
+	return &Member{
+		CohortID:  cohortID,
+		StudentID: student.ID,
+		JoinedAt:  time.Now(),
+	}, nil
+}
+
+// NormalizeJoinCode accepts a code as typed by a student: in any case, with
+// spaces or dashes between groups.
+func NormalizeJoinCode(code string) (string, error) {
+	code = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	if len(code) != joinCodeLength {
+		return "", ErrInvalidJoinCode
+	}
+
+	for _, r := range code {
+		if !strings.ContainsRune(joinCodeAlphabet, r) {
+			return "", ErrInvalidJoinCode
+		}
+	}
+
+	return code, nil
+}
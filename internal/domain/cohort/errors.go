@@ -0,0 +1,14 @@
+package cohort
+
+import "errors"
+
+var (
+	ErrCohortNotFound     = errors.New("COHORT_NOT_FOUND")
+	ErrInvalidName        = errors.New("INVALID_COHORT_NAME")
+	ErrInvalidDescription = errors.New("INVALID_COHORT_DESCRIPTION")
+	ErrInvalidJoinCode    = errors.New("INVALID_JOIN_CODE")
+	ErrJoinCodeTaken      = errors.New("JOIN_CODE_TAKEN")
+	ErrNotStudent         = errors.New("NOT_A_STUDENT")
+	ErrAlreadyMember      = errors.New("ALREADY_MEMBER")
+	ErrNotMember          = errors.New("NOT_A_MEMBER")
+)
@@ -0,0 +1,53 @@
+package cohort
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*Cohort, error)
+
+	FindByJoinCode(ctx context.Context, code string) (*Cohort, error)
+
+	// List returns cohorts by name.
+	List(ctx context.Context, query ListQuery) ([]*Summary, error)
+
+	// Save returns ErrJoinCodeTaken when another cohort has the join code.
+	Save(ctx context.Context, cohort *Cohort) error
+
+	// Update returns ErrJoinCodeTaken when another cohort has the join code.
+	Update(ctx context.Context, cohort *Cohort) error
+
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Members returns the members of the cohort, earliest first.
+	Members(ctx context.Context, cohortID uuid.UUID) ([]*Member, error)
+
+	// AddMember returns ErrAlreadyMember when the student is in the cohort.
+	AddMember(ctx context.Context, member *Member) error
+
+	// RemoveMember returns ErrNotMember when the student is not in the
+	// cohort.
+	RemoveMember(ctx context.Context, cohortID, studentID uuid.UUID) error
+
+	// StudentIDs returns every student in a cohort of the mentor.
+	StudentIDs(ctx context.Context, mentorID uuid.UUID) ([]uuid.UUID, error)
+
+	// HasStudent tells whether the student is in a cohort of the mentor.
+	HasStudent(ctx context.Context, mentorID, studentID uuid.UUID) (bool, error)
+}
+
+// ListQuery filters cohorts; zero-valued filters are ignored.
+type ListQuery struct {
+	MentorID uuid.UUID
+	// StudentID limits the list to cohorts the student is a member of.
+	StudentID uuid.UUID
+}
+
+// Summary is a cohort in a listing.
+type Summary struct {
+	Cohort      *Cohort
+	MemberCount int
+}
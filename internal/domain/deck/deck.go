@@ -0,0 +1,149 @@
+package deck
+
+import (
+	"strings"
+	"time"
+	"trainer/internal/domain/user"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 2000
+	maxTerms             = 2000
+)
+
+// Deck is a set of terms a mentor prepares for students to learn.
+type Deck struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Title       string
+	Description string
+	// Terms are kept in study order.
+	Terms     []*Term
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// removedTerms are the terms removed since the deck was loaded.
+	removedTerms []uuid.UUID
+}
+
+func NewDeck(ownerID uuid.UUID, title, description string) (*Deck, error) {
+	now := time.Now()
+	d := &Deck{
+		ID:        uuid.New(),
+		OwnerID:   ownerID,
+		Terms:     make([]*Term, 0),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := d.Update(title, description); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func NewDeckFromStorage(id, ownerID uuid.UUID, title, description string, terms []*Term, createdAt, updatedAt time.Time) *Deck {
+	return &Deck{
+		ID:          id,
+		OwnerID:     ownerID,
+		Title:       title,
+		Description: description,
+		Terms:       terms,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// AuthorizeEdit lets mentors manage their own decks and admins every deck.
+func (d *Deck) AuthorizeEdit(actor user.Actor) error {
+	if err := actor.Authorize(user.PermissionCoursesManage); err != nil {
+		return err
+	}
+
+	if d.OwnerID != actor.ID && !actor.Role.Can(user.PermissionCoursesAdmin) {
+		return user.ErrAccessDenied
+	}
+
+	return nil
+}
+
+func (d *Deck) Update(title, description string) error {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLength {
+		return ErrInvalidTitle
+	}
+
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return ErrInvalidDescription
+	}
+
+	d.Title = title
+	d.Description = description
+	d.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (d *Deck) AddTerm(content TermContent) (*Term, error) {
+	if len(d.Terms) >= maxTerms {
+		return nil, ErrTooManyTerms
+	}
+
+	term, err := newTerm(d.ID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Terms = append(d.Terms, term)
+	d.UpdatedAt = term.CreatedAt
+
+	return term, nil
+}
+
+func (d *Deck) UpdateTerm(id uuid.UUID, content TermContent) (*Term, error) {
+	term := d.FindTerm(id)
+	if term == nil {
+		return nil, ErrTermNotFound
+	}
+
+	if err := term.update(content); err != nil {
+		return nil, err
+	}
+
+	d.UpdatedAt = term.UpdatedAt
+
+	return term, nil
+}
+
+func (d *Deck) RemoveTerm(id uuid.UUID) error {
+	for i, term := range d.Terms {
+		if term.ID == id {
+			d.Terms = append(d.Terms[:i], d.Terms[i+1:]...)
+			d.removedTerms = append(d.removedTerms, id)
+			d.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	return ErrTermNotFound
+}
+
+// RemovedTerms lists the terms removed since d was loaded, so the repository
+// can retire them without losing their review history.
+func (d *Deck) RemovedTerms() []uuid.UUID {
+	return d.removedTerms
+}
+
+func (d *Deck) FindTerm(id uuid.UUID) *Term {
+	for _, term := range d.Terms {
+		if term.ID == id {
+			return term
+		}
+	}
+	return nil
+}
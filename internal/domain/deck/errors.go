@@ -0,0 +1,14 @@
+package deck
+
+import "errors"
+
+var (
+	ErrDeckNotFound       = errors.New("DECK_NOT_FOUND")
+	ErrTermNotFound       = errors.New("TERM_NOT_FOUND")
+	ErrInvalidTitle       = errors.New("INVALID_DECK_TITLE")
+	ErrInvalidDescription = errors.New("INVALID_DECK_DESCRIPTION")
+	ErrInvalidTerm        = errors.New("INVALID_TERM")
+	ErrInvalidTag         = errors.New("INVALID_TAG")
+	ErrInvalidMedia       = errors.New("INVALID_MEDIA")
+	ErrTooManyTerms       = errors.New("TOO_MANY_TERMS")
+)
@@ -0,0 +1,54 @@
+package deck
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// FindByID returns the deck with its terms.
+	FindByID(ctx context.Context, id uuid.UUID) (*Deck, error)
+
+	// List returns decks without their terms, most recently updated first.
+	List(ctx context.Context, query ListQuery) (*Page, error)
+
+	// FindTerms returns the terms with the given IDs that exist, in no
+	// particular order.
+	FindTerms(ctx context.Context, ids []uuid.UUID) ([]*Term, error)
+
+	Save(ctx context.Context, deck *Deck) error
+
+	// Update stores the deck and its terms and retires the removed terms.
+	// Retired terms disappear from the deck but keep their review history.
+	Update(ctx context.Context, deck *Deck) error
+
+	// Delete retires the deck with its terms and drops its assignments.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ListQuery struct {
+	// OwnerID limits the list to one owner; uuid.Nil lists every deck.
+	OwnerID uuid.UUID
+	// Search matches the title case-insensitively.
+	Search string
+	Limit  int
+	Offset int
+}
+
+// Summary is a deck in a listing.
+type Summary struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Title       string
+	Description string
+	TermCount   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Page struct {
+	Decks []*Summary
+	Total int
+}
@@ -0,0 +1,185 @@
+package deck
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxSideLength    = 1000
+	maxExamples      = 10
+	maxExampleLength = 500
+	maxTags          = 20
+	maxTagLength     = 32
+	maxMedia         = 5
+)
+
+// Term is one flashcard: the Front is shown and the Back has to be recalled.
+type Term struct {
+	ID       uuid.UUID
+	DeckID   uuid.UUID
+	Front    string
+	Back     string
+	Examples []string
+	// Tags are lower-case and unique within the term.
+	Tags      []string
+	Media     []MediaRef
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type MediaKind string
+
+const (
+	MediaImage MediaKind = "image"
+	MediaAudio MediaKind = "audio"
+)
+
+// MediaRef points at an image or a pronunciation recording stored elsewhere.
+type MediaRef struct {
+	Kind MediaKind
+	URL  string
+}
+
+// TermContent is everything about a term that its author edits.
+type TermContent struct {
+	Front    string
+	Back     string
+	Examples []string
+	Tags     []string
+	Media    []MediaRef
+}
+
+func newTerm(deckID uuid.UUID, content TermContent) (*Term, error) {
+	now := time.Now()
+	term := &Term{
+		ID:        uuid.New(),
+		DeckID:    deckID,
+		CreatedAt: now,
+	}
+
+	if err := term.update(content); err != nil {
+		return nil, err
+	}
+
+	return term, nil
+}
+
+func NewTermFromStorage(id, deckID uuid.UUID, front, back string, examples, tags []string, media []MediaRef, createdAt, updatedAt time.Time) *Term {
+	return &Term{
+		ID:        id,
+		DeckID:    deckID,
+		Front:     front,
+		Back:      back,
+		Examples:  examples,
+		Tags:      tags,
+		Media:     media,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// update replaces the content of the term; nothing changes when it is invalid.
+func (t *Term) update(content TermContent) error {
+	front := strings.TrimSpace(content.Front)
+	back := strings.TrimSpace(content.Back)
+	if !isValidText(front, maxSideLength) || !isValidText(back, maxSideLength) {
+		return ErrInvalidTerm
+	}
+
+	examples, err := normalizeExamples(content.Examples)
+	if err != nil {
+		return err
+	}
+
+	tags, err := normalizeTags(content.Tags)
+	if err != nil {
+		return err
+	}
+
+	media, err := validateMedia(content.Media)
+	if err != nil {
+		return err
+	}
+
+	t.Front = front
+	t.Back = back
+	t.Examples = examples
+	t.Tags = tags
+	t.Media = media
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func isValidText(s string, limit int) bool {
+	return s != "" && utf8.RuneCountInString(s) <= limit
+}
+
+func normalizeExamples(examples []string) ([]string, error) {
+	if len(examples) > maxExamples {
+		return nil, ErrInvalidTerm
+	}
+
+	normalized := make([]string, 0, len(examples))
+	for _, example := range examples {
+		example = strings.TrimSpace(example)
+		if !isValidText(example, maxExampleLength) {
+			return nil, ErrInvalidTerm
+		}
+		normalized = append(normalized, example)
+	}
+
+	return normalized, nil
+}
+
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !isValidText(tag, maxTagLength) || strings.ContainsAny(tag, ",\n\t") {
+			return nil, ErrInvalidTag
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if len(normalized) > maxTags {
+		return nil, ErrInvalidTag
+	}
+
+	return normalized, nil
+}
+
+// validateMedia accepts absolute http(s) URLs and paths on this server, such
+// as files from the upload storage.
+func validateMedia(media []MediaRef) ([]MediaRef, error) {
+	if len(media) > maxMedia {
+		return nil, ErrInvalidMedia
+	}
+
+	for _, ref := range media {
+		if ref.Kind != MediaImage && ref.Kind != MediaAudio {
+			return nil, ErrInvalidMedia
+		}
+
+		u, err := url.Parse(ref.URL)
+		if err != nil || ref.URL == "" {
+			return nil, ErrInvalidMedia
+		}
+
+		isPath := u.Scheme == "" && u.Host == "" && strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(ref.URL, "//")
+		isHTTP := (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+		if !isPath && !isHTTP {
+			return nil, ErrInvalidMedia
+		}
+	}
+
+	return append(make([]MediaRef, 0, len(media)), media...), nil
+}
@@ -0,0 +1,8 @@
+package review
+
+import "errors"
+
+var (
+	ErrInvalidGrade     = errors.New("INVALID_GRADE")
+	ErrUnknownAlgorithm = errors.New("UNKNOWN_ALGORITHM")
+)
@@ -0,0 +1,104 @@
+package review
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	fsrsDecay = -0.5
+	// fsrsFactor makes the retrievability 90% when the elapsed time equals
+	// the stability.
+	fsrsFactor = 19.0 / 81.0
+)
+
+// fsrsWeights are the published default parameters of FSRS-4.5.
+var fsrsWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206, 5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461, 2.1072, 0.0793, 0.3246, 1.587, 0.2272, 2.8755,
+}
+
+// FSRS is the Free Spaced Repetition Scheduler (version 4.5). It models the
+// memory of a term by its stability and difficulty and picks the interval
+// at which recall drops to the desired retention.
+type FSRS struct {
+	w                [17]float64
+	desiredRetention float64
+	maxIntervalDays  int
+}
+
+func NewFSRS(desiredRetention float64, maxIntervalDays int) *FSRS {
+	return &FSRS{
+		w:                fsrsWeights,
+		desiredRetention: desiredRetention,
+		maxIntervalDays:  maxIntervalDays,
+	}
+}
+
+func (f *FSRS) Name() string {
+	return AlgorithmFSRS
+}
+
+// Schedule treats a state without stability as new, which also covers
+// terms scheduled by SM-2 before switching algorithms.
+func (f *FSRS) Schedule(state State, grade Grade, now time.Time) State {
+	g := float64(grade)
+
+	if state.Stability == 0 {
+		state.Stability = f.w[grade-1]
+		state.Difficulty = f.initialDifficulty(g)
+	} else {
+		r := f.retrievability(state.elapsedDays(now), state.Stability)
+		if grade == GradeAgain {
+			state.Stability = math.Min(f.forgetStability(state.Difficulty, state.Stability, r), state.Stability)
+		} else {
+			state.Stability = f.recallStability(state.Difficulty, state.Stability, r, grade)
+		}
+		state.Difficulty = f.nextDifficulty(state.Difficulty, g)
+	}
+
+	if grade == GradeAgain {
+		return reviewed(state, grade, 0, now)
+	}
+
+	return reviewed(state, grade, clampInterval(f.interval(state.Stability), f.maxIntervalDays), now)
+}
+
+func (f *FSRS) retrievability(elapsedDays, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsedDays/stability, fsrsDecay)
+}
+
+func (f *FSRS) interval(stability float64) float64 {
+	return stability / fsrsFactor * (math.Pow(f.desiredRetention, 1/fsrsDecay) - 1)
+}
+
+func (f *FSRS) initialDifficulty(g float64) float64 {
+	return clampDifficulty(f.w[4] - (g-3)*f.w[5])
+}
+
+// nextDifficulty moves the difficulty by the grade and then reverts it
+// slightly towards the difficulty of a new term graded Easy.
+func (f *FSRS) nextDifficulty(d, g float64) float64 {
+	next := d - f.w[6]*(g-3)
+	return clampDifficulty(f.w[7]*f.initialDifficulty(4) + (1-f.w[7])*next)
+}
+
+func (f *FSRS) recallStability(d, s, r float64, grade Grade) float64 {
+	hardPenalty, easyBonus := 1.0, 1.0
+	if grade == GradeHard {
+		hardPenalty = f.w[15]
+	}
+	if grade == GradeEasy {
+		easyBonus = f.w[16]
+	}
+
+	return s * (math.Exp(f.w[8])*(11-d)*math.Pow(s, -f.w[9])*(math.Exp(f.w[10]*(1-r))-1)*hardPenalty*easyBonus + 1)
+}
+
+func (f *FSRS) forgetStability(d, s, r float64) float64 {
+	return f.w[11] * math.Pow(d, -f.w[12]) * (math.Pow(s+1, f.w[13]) - 1) * math.Exp(f.w[14]*(1-r))
+}
+
+func clampDifficulty(d float64) float64 {
+	return math.Min(math.Max(d, 1), 10)
+}
@@ -0,0 +1,91 @@
+package review
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFSRSScheduleNewTerm(t *testing.T) {
+	tests := []struct {
+		name           string
+		grade          Grade
+		wantStability  float64
+		wantDifficulty float64
+		wantInterval   int
+	}{
+		{"again", GradeAgain, 0.4872, 7.6214, 0},
+		{"hard", GradeHard, 1.4003, 6.3916, 1},
+		{"good", GradeGood, 3.7145, 5.1618, 4},
+		{"easy", GradeEasy, 13.8206, 3.932, 14},
+	}
+
+	fsrs := NewFSRS(0.9, 3650)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fsrs.Schedule(*NewState(uuid.New(), uuid.New(), uuid.New(), testNow), tt.grade, testNow)
+
+			if !almostEqual(got.Stability, tt.wantStability) {
+				t.Errorf("stability = %v, want %v", got.Stability, tt.wantStability)
+			}
+			if !almostEqual(got.Difficulty, tt.wantDifficulty) {
+				t.Errorf("difficulty = %v, want %v", got.Difficulty, tt.wantDifficulty)
+			}
+			if got.IntervalDays != tt.wantInterval {
+				t.Errorf("interval = %d, want %d", got.IntervalDays, tt.wantInterval)
+			}
+			if got.Lapses != 0 {
+				t.Errorf("lapses = %d, want 0", got.Lapses)
+			}
+		})
+	}
+}
+
+// A term with stability 10 reviewed 10 days later is recalled with the
+// desired 90% probability.
+func TestFSRSScheduleReviewedTerm(t *testing.T) {
+	tests := []struct {
+		name           string
+		grade          Grade
+		wantStability  float64
+		wantDifficulty float64
+		wantInterval   int
+		wantLapses     int
+	}{
+		{"again", GradeAgain, 2.5604, 6.7062, 0, 1},
+		{"hard", GradeHard, 15.6991, 5.8366, 16, 0},
+		{"good", GradeGood, 35.0839, 4.9669, 35, 0},
+		{"easy", GradeEasy, 82.1287, 4.0972, 82, 0},
+	}
+
+	fsrs := NewFSRS(0.9, 3650)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fsrs.Schedule(reviewedState(initialEase, 10, 10, 5, 10), tt.grade, testNow)
+
+			if !almostEqual(got.Stability, tt.wantStability) {
+				t.Errorf("stability = %v, want %v", got.Stability, tt.wantStability)
+			}
+			if !almostEqual(got.Difficulty, tt.wantDifficulty) {
+				t.Errorf("difficulty = %v, want %v", got.Difficulty, tt.wantDifficulty)
+			}
+			if got.IntervalDays != tt.wantInterval {
+				t.Errorf("interval = %d, want %d", got.IntervalDays, tt.wantInterval)
+			}
+			if got.Lapses != tt.wantLapses {
+				t.Errorf("lapses = %d, want %d", got.Lapses, tt.wantLapses)
+			}
+		})
+	}
+}
+
+func TestFSRSScheduleCapsInterval(t *testing.T) {
+	got := NewFSRS(0.9, 30).Schedule(reviewedState(initialEase, 10, 10, 5, 10), GradeEasy, testNow)
+
+	if got.IntervalDays != 30 {
+		t.Errorf("interval = %d, want 30", got.IntervalDays)
+	}
+	if want := testNow.AddDate(0, 0, 30); !got.DueAt.Equal(want) {
+		t.Errorf("due = %v, want %v", got.DueAt, want)
+	}
+}
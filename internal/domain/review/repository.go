@@ -0,0 +1,24 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// FindState returns nil when the student has never reviewed the term.
+	FindState(ctx context.Context, studentID, termID uuid.UUID) (*State, error)
+
+	// FindDue returns the states of the given decks due at now, most overdue
+	// first.
+	FindDue(ctx context.Context, studentID uuid.UUID, deckIDs []uuid.UUID, now time.Time, limit int) ([]*State, error)
+
+	// FindNewTerms returns the terms of the deck the student has never
+	// reviewed, in deck order.
+	FindNewTerms(ctx context.Context, studentID, deckID uuid.UUID, limit int) ([]uuid.UUID, error)
+
+	// Save stores the state together with the review that produced it.
+	Save(ctx context.Context, state *State, log *Log) error
+}
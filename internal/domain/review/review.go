@@ -0,0 +1,106 @@
+package review
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Grade is how well a student recalled a term, on the four-button scale
+// shared by SM-2 and FSRS.
+type Grade int
+
+const (
+	GradeAgain Grade = 1
+	GradeHard  Grade = 2
+	GradeGood  Grade = 3
+	GradeEasy  Grade = 4
+)
+
+func (g Grade) IsValid() bool {
+	return g >= GradeAgain && g <= GradeEasy
+}
+
+const (
+	initialEase = 2.5
+	// relearnDelay brings a forgotten term back within the same session.
+	relearnDelay = 10 * time.Minute
+)
+
+// State is the review state of one term for one student. Ease is used by
+// SM-2; Stability (in days) and Difficulty by FSRS.
+type State struct {
+	StudentID      uuid.UUID
+	TermID         uuid.UUID
+	DeckID         uuid.UUID
+	Ease           float64
+	IntervalDays   int
+	Stability      float64
+	Difficulty     float64
+	Reps           int
+	Lapses         int
+	DueAt          time.Time
+	LastReviewedAt *time.Time
+}
+
+// NewState is the state of a term the student has not reviewed yet; it is
+// due right away.
+func NewState(studentID, termID, deckID uuid.UUID, now time.Time) *State {
+	return &State{
+		StudentID: studentID,
+		TermID:    termID,
+		DeckID:    deckID,
+		Ease:      initialEase,
+		DueAt:     now,
+	}
+}
+
+func (s *State) IsNew() bool {
+	return s.LastReviewedAt == nil
+}
+
+func (s *State) IsDue(now time.Time) bool {
+	return !s.DueAt.After(now)
+}
+
+// elapsedDays is the time since the last review, in days.
+func (s *State) elapsedDays(now time.Time) float64 {
+	if s.LastReviewedAt == nil {
+		return 0
+	}
+	return max(now.Sub(*s.LastReviewedAt).Hours()/24, 0)
+}
+
+// Log records a single review, for analytics and for replaying history into
+// another algorithm.
+type Log struct {
+	ID           uuid.UUID
+	StudentID    uuid.UUID
+	TermID       uuid.UUID
+	DeckID       uuid.UUID
+	Grade        Grade
+	Algorithm    string
+	IntervalDays int
+	// NextIntervalDays is the interval the review produced; 0 when the term
+	// is relearned within the session.
+	NextIntervalDays int
+	// Duration is how long the student took to answer, as reported by the
+	// client.
+	Duration   time.Duration
+	ReviewedAt time.Time
+}
+
+func NewLogFromStorage(id, studentID, termID, deckID uuid.UUID, grade Grade, algorithm string, intervalDays, nextIntervalDays int, duration time.Duration, reviewedAt time.Time) *Log {
+	return &Log{
+		ID:               id,
+		StudentID:        studentID,
+		TermID:           termID,
+		DeckID:           deckID,
+		Grade:            grade,
+		Algorithm:        algorithm,
+		IntervalDays:     intervalDays,
+		NextIntervalDays: nextIntervalDays,
+		Duration:         duration,
+		ReviewedAt:       reviewedAt,
+	}
+}
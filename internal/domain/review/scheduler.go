@@ -0,0 +1,66 @@
+package review
+
+import (
+	"math"
+	"time"
+)
+
+// Scheduler computes the state after a review. Implementations must not
+// read the current time themselves, so they can be driven by any clock.
+type Scheduler interface {
+	Name() string
+	Schedule(state State, grade Grade, now time.Time) State
+}
+
+// Clock tells the time; tests and replays substitute their own.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+const (
+	AlgorithmSM2  = "sm2"
+	AlgorithmFSRS = "fsrs"
+)
+
+// NewScheduler returns the scheduler for a configured algorithm name.
+func NewScheduler(algorithm string, desiredRetention float64, maxIntervalDays int) (Scheduler, error) {
+	switch algorithm {
+	case AlgorithmSM2:
+		return NewSM2(maxIntervalDays), nil
+	case AlgorithmFSRS:
+		return NewFSRS(desiredRetention, maxIntervalDays), nil
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
+
+// reviewed applies what every algorithm does on a review: counting, moving
+// the due date and remembering when the review happened.
+func reviewed(state State, grade Grade, intervalDays int, now time.Time) State {
+	state.Reps++
+	if grade == GradeAgain {
+		if !state.IsNew() {
+			state.Lapses++
+		}
+		state.IntervalDays = 0
+		state.DueAt = now.Add(relearnDelay)
+	} else {
+		state.IntervalDays = intervalDays
+		state.DueAt = now.AddDate(0, 0, intervalDays)
+	}
+
+	reviewedAt := now
+	state.LastReviewedAt = &reviewedAt
+
+	return state
+}
+
+func clampInterval(days float64, maxIntervalDays int) int {
+	return int(math.Min(math.Max(math.Round(days), 1), float64(maxIntervalDays)))
+}
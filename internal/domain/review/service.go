@@ -0,0 +1,47 @@
+package review
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	scheduler Scheduler
+	clock     Clock
+}
+
+func NewService(scheduler Scheduler, clock Clock) *Service {
+	return &Service{
+		scheduler: scheduler,
+		clock:     clock,
+	}
+}
+
+func (s *Service) Now() time.Time {
+	return s.clock.Now()
+}
+
+// Review applies a grade to the state and returns the log of the review.
+func (s *Service) Review(state *State, grade Grade, duration time.Duration) (*Log, error) {
+	if !grade.IsValid() {
+		return nil, ErrInvalidGrade
+	}
+
+	now := s.clock.Now()
+	previous := state.IntervalDays
+	*state = s.scheduler.Schedule(*state, grade, now)
+
+	return &Log{
+		ID:               uuid.New(),
+		StudentID:        state.StudentID,
+		TermID:           state.TermID,
+		DeckID:           state.DeckID,
+		Grade:            grade,
+		Algorithm:        s.scheduler.Name(),
+		IntervalDays:     previous,
+		NextIntervalDays: state.IntervalDays,
+		Duration:         max(duration, 0),
+		ReviewedAt:       now,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package review
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestServiceReviewUsesClock(t *testing.T) {
+	service := NewService(NewSM2(3650), fixedClock(testNow))
+	state := NewState(uuid.New(), uuid.New(), uuid.New(), testNow)
+
+	log, err := service.Review(state, GradeGood, 4*time.Second)
+	if err != nil {
+		t.Fatalf("review: %v", err)
+	}
+
+	if !log.ReviewedAt.Equal(testNow) {
+		t.Errorf("reviewed at = %v, want %v", log.ReviewedAt, testNow)
+	}
+	if want := testNow.AddDate(0, 0, 1); !state.DueAt.Equal(want) {
+		t.Errorf("due = %v, want %v", state.DueAt, want)
+	}
+	if log.IntervalDays != 0 || log.NextIntervalDays != 1 {
+		t.Errorf("log intervals = %d -> %d, want 0 -> 1", log.IntervalDays, log.NextIntervalDays)
+	}
+	if log.Algorithm != AlgorithmSM2 || log.TermID != state.TermID || log.Duration != 4*time.Second {
+		t.Errorf("unexpected log %+v", log)
+	}
+}
+
+func TestServiceReviewRejectsInvalidGrade(t *testing.T) {
+	service := NewService(NewFSRS(0.9, 3650), fixedClock(testNow))
+	state := NewState(uuid.New(), uuid.New(), uuid.New(), testNow)
+
+	for _, grade := range []Grade{0, 5} {
+		if _, err := service.Review(state, grade, 0); !errors.Is(err, ErrInvalidGrade) {
+			t.Errorf("grade %d: err = %v, want %v", grade, err, ErrInvalidGrade)
+		}
+	}
+	if !state.IsNew() {
+		t.Error("state changed by a rejected review")
+	}
+}
@@ -0,0 +1,50 @@
+package review
+
+import (
+	"math"
+	"time"
+)
+
+const minEase = 1.3
+
+// SM2 is the SuperMemo-2 algorithm with the four grades mapped onto its
+// 0-5 quality scale as Again=2, Hard=3, Good=4 and Easy=5.
+type SM2 struct {
+	maxIntervalDays int
+}
+
+func NewSM2(maxIntervalDays int) *SM2 {
+	return &SM2{maxIntervalDays: maxIntervalDays}
+}
+
+func (s *SM2) Name() string {
+	return AlgorithmSM2
+}
+
+func (s *SM2) Schedule(state State, grade Grade, now time.Time) State {
+	quality := float64(grade) + 1
+
+	ease := state.Ease
+	if ease == 0 {
+		ease = initialEase
+	}
+	ease += 0.1 - (5-quality)*(0.08+(5-quality)*0.02)
+	state.Ease = math.Max(ease, minEase)
+
+	if grade == GradeAgain {
+		return reviewed(state, grade, 0, now)
+	}
+
+	// The interval restarts after a lapse, which leaves it at 0.
+	var interval float64
+	switch state.IntervalDays {
+	case 0:
+		interval = 1
+	case 1:
+		interval = 6
+	default:
+		interval = float64(state.IntervalDays) * state.Ease
+	}
+
+	return reviewed(state, grade, clampInterval(interval, s.maxIntervalDays), now)
+}
@@ -0,0 +1,93 @@
+package review
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fixedClock always tells the same time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+var testNow = time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+
+// reviewedState is a term last reviewed daysAgo days before testNow.
+func reviewedState(ease float64, intervalDays int, stability, difficulty float64, daysAgo int) State {
+	last := testNow.AddDate(0, 0, -daysAgo)
+	return State{
+		StudentID:      uuid.New(),
+		TermID:         uuid.New(),
+		DeckID:         uuid.New(),
+		Ease:           ease,
+		IntervalDays:   intervalDays,
+		Stability:      stability,
+		Difficulty:     difficulty,
+		Reps:           3,
+		DueAt:          testNow,
+		LastReviewedAt: &last,
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-3
+}
+
+func TestSM2Schedule(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        State
+		grade        Grade
+		wantEase     float64
+		wantInterval int
+		wantLapses   int
+	}{
+		{"new again", *NewState(uuid.New(), uuid.New(), uuid.New(), testNow), GradeAgain, 2.18, 0, 0},
+		{"new hard", *NewState(uuid.New(), uuid.New(), uuid.New(), testNow), GradeHard, 2.36, 1, 0},
+		{"new good", *NewState(uuid.New(), uuid.New(), uuid.New(), testNow), GradeGood, 2.5, 1, 0},
+		{"new easy", *NewState(uuid.New(), uuid.New(), uuid.New(), testNow), GradeEasy, 2.6, 1, 0},
+		{"second review", reviewedState(2.5, 1, 0, 0, 1), GradeGood, 2.5, 6, 0},
+		{"mature again", reviewedState(2.5, 6, 0, 0, 6), GradeAgain, 2.18, 0, 1},
+		{"mature hard", reviewedState(2.5, 6, 0, 0, 6), GradeHard, 2.36, 14, 0},
+		{"mature good", reviewedState(2.5, 6, 0, 0, 6), GradeGood, 2.5, 15, 0},
+		{"mature easy", reviewedState(2.5, 6, 0, 0, 6), GradeEasy, 2.6, 16, 0},
+		{"ease floor", reviewedState(minEase, 6, 0, 0, 6), GradeAgain, minEase, 0, 1},
+		{"max interval", reviewedState(2.5, 3000, 0, 0, 3000), GradeGood, 2.5, 3650, 0},
+	}
+
+	sm2 := NewSM2(3650)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sm2.Schedule(tt.state, tt.grade, testNow)
+
+			if !almostEqual(got.Ease, tt.wantEase) {
+				t.Errorf("ease = %v, want %v", got.Ease, tt.wantEase)
+			}
+			if got.IntervalDays != tt.wantInterval {
+				t.Errorf("interval = %d, want %d", got.IntervalDays, tt.wantInterval)
+			}
+			if got.Lapses != tt.wantLapses {
+				t.Errorf("lapses = %d, want %d", got.Lapses, tt.wantLapses)
+			}
+			if got.Reps != tt.state.Reps+1 {
+				t.Errorf("reps = %d, want %d", got.Reps, tt.state.Reps+1)
+			}
+
+			wantDue := testNow.AddDate(0, 0, tt.wantInterval)
+			if tt.grade == GradeAgain {
+				wantDue = testNow.Add(relearnDelay)
+			}
+			if !got.DueAt.Equal(wantDue) {
+				t.Errorf("due = %v, want %v", got.DueAt, wantDue)
+			}
+			if got.LastReviewedAt == nil || !got.LastReviewedAt.Equal(testNow) {
+				t.Errorf("last reviewed = %v, want %v", got.LastReviewedAt, testNow)
+			}
+		})
+	}
+}
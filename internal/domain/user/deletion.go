@@ -0,0 +1,17 @@
+package user
+
+import "time"
+
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+func (u *User) markDeleted(at time.Time) {
+	u.DeletedAt = &at
+	u.UpdatedAt = at
+}
+
+func (u *User) restore() {
+	u.DeletedAt = nil
+	u.UpdatedAt = time.Now()
+}
@@ -0,0 +1,113 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+)
+
+// plainHasher keeps passwords as they are, which is enough for tests.
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) { return password, nil }
+
+func (plainHasher) Compare(hash, password string) bool { return hash == password }
+
+func newEmailTestService(t *testing.T) (*user.Service, user.Repository, *user.User) {
+	t.Helper()
+	repo := memory.NewUserRepository()
+	s := user.NewService(repo, plainHasher{}, user.Policy{EmailVerificationTTL: time.Hour})
+
+	verifiedAt := time.Now()
+	u := user.NewUserFromStorage(uuid.New(), "ann@example.com", "Ann", "Lee", "secret", user.RoleStudent,
+		&verifiedAt, user.MFASettings{}, user.Profile{}, time.Now(), time.Now(), nil, 1, nil)
+	if err := repo.Save(context.Background(), u); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	return s, repo, u
+}
+
+func TestChangeEmailKeepsCurrentAddressUntilVerified(t *testing.T) {
+	s, _, u := newEmailTestService(t)
+	ctx := context.Background()
+
+	if err := s.ChangeEmail(ctx, u, "ann@exmaple.com", "secret"); err != nil {
+		t.Fatalf("ChangeEmail: %v", err)
+	}
+	if u.Email != "ann@example.com" || !u.IsEmailVerified() {
+		t.Errorf("email %q verified %v, want the old address still verified", u.Email, u.IsEmailVerified())
+	}
+	if u.PendingEmail != "ann@exmaple.com" {
+		t.Errorf("pending email %q", u.PendingEmail)
+	}
+
+	token, _, err := s.RequestEmailVerification(ctx, u)
+	if err != nil {
+		t.Fatalf("RequestEmailVerification: %v", err)
+	}
+	if token.Email != u.PendingEmail {
+		t.Errorf("link sent to %q, want the pending address", token.Email)
+	}
+
+	// Fixing the typo replaces the pending address; the old link is void.
+	if err := s.ChangeEmail(ctx, u, "ann@example.org", "secret"); err != nil {
+		t.Fatalf("ChangeEmail: %v", err)
+	}
+	if err := s.VerifyEmail(ctx, u, token); !errors.Is(err, user.ErrInvalidVerification) {
+		t.Errorf("stale link: got %v, want %v", err, user.ErrInvalidVerification)
+	}
+
+	token, _, err = s.RequestEmailVerification(ctx, u)
+	if err != nil {
+		t.Fatalf("RequestEmailVerification: %v", err)
+	}
+	if err := s.VerifyEmail(ctx, u, token); err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	if u.Email != "ann@example.org" || u.PendingEmail != "" || !u.IsEmailVerified() {
+		t.Errorf("email %q pending %q verified %v after the link", u.Email, u.PendingEmail, u.IsEmailVerified())
+	}
+}
+
+func TestChangeEmailRejects(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("wrong password", func(t *testing.T) {
+		s, _, u := newEmailTestService(t)
+		if err := s.ChangeEmail(ctx, u, "ann@example.org", "guess"); !errors.Is(err, user.ErrIncorrectPassword) {
+			t.Errorf("got %v, want %v", err, user.ErrIncorrectPassword)
+		}
+	})
+
+	t.Run("address taken", func(t *testing.T) {
+		s, repo, u := newEmailTestService(t)
+		other := user.NewUserFromStorage(uuid.New(), "bob@example.com", "Bob", "Ray", "", user.RoleStudent,
+			nil, user.MFASettings{}, user.Profile{}, time.Now(), time.Now(), nil, 1, nil)
+		if err := repo.Save(ctx, other); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		if err := s.ChangeEmail(ctx, u, "bob@example.com", "secret"); !errors.Is(err, user.ErrEmailAlreadyUsed) {
+			t.Errorf("got %v, want %v", err, user.ErrEmailAlreadyUsed)
+		}
+	})
+
+	t.Run("current address cancels", func(t *testing.T) {
+		s, _, u := newEmailTestService(t)
+		if err := s.ChangeEmail(ctx, u, "ann@example.org", "secret"); err != nil {
+			t.Fatalf("ChangeEmail: %v", err)
+		}
+		if err := s.ChangeEmail(ctx, u, "ann@example.com", "secret"); err != nil {
+			t.Fatalf("ChangeEmail: %v", err)
+		}
+		if u.PendingEmail != "" {
+			t.Errorf("pending email %q, want the change cancelled", u.PendingEmail)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+func (t *EmailVerificationToken) IsValid() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
+
+func (t *EmailVerificationToken) use(at time.Time) {
+	t.UsedAt = &at
+}
+
+func newEmailVerificationToken(userID uuid.UUID, email string, duration time.Duration) (*EmailVerificationToken, string, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	return &EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Email:     email,
+		TokenHash: HashSecret(secret),
+		ExpiresAt: now.Add(duration),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func NewEmailVerificationTokenFromStorage(id, userID uuid.UUID, email, tokenHash string, expiresAt, createdAt time.Time, usedAt *time.Time) *EmailVerificationToken {
+	return &EmailVerificationToken{
+		ID:        id,
+		UserID:    userID,
+		Email:     email,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+		UsedAt:    usedAt,
+	}
+}
@@ -1,6 +1,9 @@
 package user
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrUserNotFound        = errors.New("USER_NOT_FOUND")
@@ -12,7 +15,62 @@ var (
 	ErrEmptyPassword       = errors.New("EMPTY_PASSWORD")
 	ErrEmptyEmail          = errors.New("EMPTY_EMAIL")
 	ErrInvalidRefreshToken = errors.New("INVALID_REFRESH_TOKEN")
-	ErrNotFound            = errors.New("USER_NOT_FOUND")
 	ErrFailedUpdate        = errors.New("UPDATE_FAILED")
 	ErrTokenRefresh        = errors.New("REFRESH_TOKEN_FAILED")
+	ErrRefreshTokenReused  = errors.New("REFRESH_TOKEN_REUSED")
+	ErrSessionNotFound     = errors.New("SESSION_NOT_FOUND")
+	ErrInvalidResetToken   = errors.New("INVALID_RESET_TOKEN")
+	ErrEmailNotVerified    = errors.New("EMAIL_NOT_VERIFIED")
+	ErrEmailVerified       = errors.New("EMAIL_ALREADY_VERIFIED")
+	ErrInvalidVerification = errors.New("INVALID_VERIFICATION_TOKEN")
+	ErrAccountLocked       = errors.New("ACCOUNT_LOCKED")
+	ErrTooManyAttempts     = errors.New("TOO_MANY_ATTEMPTS")
+	ErrMFAAlreadyEnabled   = errors.New("MFA_ALREADY_ENABLED")
+	ErrMFANotEnabled       = errors.New("MFA_NOT_ENABLED")
+	ErrMFARequired         = errors.New("MFA_REQUIRED")
+	ErrInvalidMFACode      = errors.New("INVALID_MFA_CODE")
+	ErrInvalidMFAChallenge = errors.New("INVALID_MFA_CHALLENGE")
+	ErrLastAdmin           = errors.New("LAST_ADMIN")
+	ErrSelfPromotion       = errors.New("SELF_PROMOTION_FORBIDDEN")
+	ErrInvalidCursor       = errors.New("INVALID_CURSOR")
+	ErrUserNotDeleted      = errors.New("USER_NOT_DELETED")
+	ErrRestoreExpired      = errors.New("RESTORE_WINDOW_EXPIRED")
+	// ErrInvalidCredentials rejects a login without telling whether the
+	// email or the password was wrong.
+	ErrInvalidCredentials = errors.New("INVALID_CREDENTIALS")
+	// ErrVersionConflict means the user changed between load and update.
+	ErrVersionConflict = errors.New("VERSION_CONFLICT")
+	// ErrPreconditionFailed means the client edited an outdated version.
+	ErrPreconditionFailed = errors.New("PRECONDITION_FAILED")
+	// ErrIncorrectPassword means the password confirming a change to the
+	// caller's own account is wrong.
+	ErrIncorrectPassword = errors.New("INCORRECT_PASSWORD")
+	// ErrUseAccountEndpoint means a user tried to change their own password
+	// or email through user management instead of the /me endpoints.
+	ErrUseAccountEndpoint = errors.New("USE_ACCOUNT_ENDPOINT")
+	ErrInvalidDisplayName = errors.New("INVALID_DISPLAY_NAME")
+	ErrInvalidLocale      = errors.New("INVALID_LOCALE")
+	ErrInvalidTimezone    = errors.New("INVALID_TIMEZONE")
+	ErrBioTooLong         = errors.New("BIO_TOO_LONG")
+	ErrInvalidAvatar      = errors.New("INVALID_AVATAR")
+	ErrAvatarNotFound     = errors.New("AVATAR_NOT_FOUND")
 )
+
+// RetryError wraps ErrAccountLocked and ErrTooManyAttempts with the moment the
+// client may try again.
+type RetryError struct {
+	Err   error
+	Until time.Time
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+func (e *RetryError) RetryAfter() time.Duration {
+	return time.Until(e.Until)
+}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByEmail     SortField = "email"
+	SortByLastName  SortField = "last_name"
+)
+
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByEmail, SortByLastName:
+		return true
+	default:
+		return false
+	}
+}
+
+// ListQuery selects a page of users. Zero-valued filters are ignored; After,
+// when set, takes precedence over Offset.
+type ListQuery struct {
+	// IDs limits the list to these users when not nil.
+	IDs         []uuid.UUID
+	Role        Role
+	Search      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      SortField
+	Descending  bool
+	Limit       int
+	Offset      int
+	After       *ListCursor
+}
+
+type UserPage struct {
+	Users []*User
+	// Total counts every user matching the filters, not just this page.
+	Total int
+	Next  *ListCursor
+}
+
+// ListCursor points just past the last user of a page. It remembers the sort
+// it was issued for, since its Value is meaningless under another order.
+type ListCursor struct {
+	SortBy     SortField `json:"s"`
+	Descending bool      `json:"d,omitempty"`
+	Value      string    `json:"v"`
+	ID         uuid.UUID `json:"id"`
+}
+
+func (c *ListCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeListCursor(s string) (*ListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c ListCursor
+	if err := json.Unmarshal(data, &c); err != nil || !c.SortBy.IsValid() {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
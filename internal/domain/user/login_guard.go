@@ -0,0 +1,179 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// LoginAttempts counts consecutive failed logins for an account or a client IP.
+type LoginAttempts struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+func NewLoginAttemptsFromStorage(key string, failures int, lastFailureAt time.Time, lockedUntil *time.Time) *LoginAttempts {
+	return &LoginAttempts{
+		Key:           key,
+		Failures:      failures,
+		LastFailureAt: lastFailureAt,
+		LockedUntil:   lockedUntil,
+	}
+}
+
+// IsStale reports whether the lock expired or, without a lock, the last
+// failure came before forgetBefore, so counting can start over.
+func (a *LoginAttempts) IsStale(now, forgetBefore time.Time) bool {
+	if a.LockedUntil != nil {
+		return !now.Before(*a.LockedUntil)
+	}
+
+	return !a.LastFailureAt.After(forgetBefore)
+}
+
+type LockoutPolicy struct {
+	// FreeAttempts failures are allowed before delays kick in.
+	FreeAttempts int
+	// BaseDelay is doubled with every failure past FreeAttempts.
+	BaseDelay          time.Duration
+	MaxAccountFailures int
+	MaxIPFailures      int
+	// LockoutDuration is how long a key stays locked, and also how long a
+	// failure is remembered.
+	LockoutDuration time.Duration
+}
+
+// LoginGuard throttles password guessing: every failure delays the next
+// attempt a bit more, and too many of them lock the account or IP for a while.
+type LoginGuard struct {
+	repo   LoginAttemptRepository
+	policy LockoutPolicy
+	now    func() time.Time
+}
+
+func NewLoginGuard(repo LoginAttemptRepository, policy LockoutPolicy) *LoginGuard {
+	return &LoginGuard{
+		repo:   repo,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// WithClock replaces the time source, for tests.
+func (g *LoginGuard) WithClock(now func() time.Time) *LoginGuard {
+	g.now = now
+	return g
+}
+
+// Check returns a *RetryError if a login for email from ip must be refused
+// without even looking at the password.
+func (g *LoginGuard) Check(ctx context.Context, email, ip string) error {
+	for _, key := range g.keys(email, ip) {
+		attempts, err := g.repo.Find(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if err := g.check(attempts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *LoginGuard) RecordFailure(ctx context.Context, email, ip string) error {
+	now := g.now()
+
+	for _, key := range g.keys(email, ip) {
+		attempts, err := g.repo.Increment(ctx, key, now, now.Add(-g.policy.LockoutDuration))
+		if err != nil {
+			return err
+		}
+
+		if attempts.Failures >= g.maxFailures(key) && attempts.LockedUntil == nil {
+			if err := g.repo.Lock(ctx, key, now.Add(g.policy.LockoutDuration)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordSuccess forgets the account failures. The IP counter is kept so that
+// one valid account cannot be used to reset it.
+func (g *LoginGuard) RecordSuccess(ctx context.Context, email string) error {
+	return g.repo.Delete(ctx, accountKey(email))
+}
+
+func (g *LoginGuard) Unlock(ctx context.Context, u *User) error {
+	return g.repo.Delete(ctx, accountKey(u.Email))
+}
+
+func (g *LoginGuard) check(attempts *LoginAttempts) error {
+	if attempts == nil {
+		return nil
+	}
+
+	now := g.now()
+
+	if attempts.LockedUntil != nil && now.Before(*attempts.LockedUntil) {
+		return &RetryError{Err: ErrAccountLocked, Until: *attempts.LockedUntil}
+	}
+
+	if g.isStale(attempts, now) {
+		return nil
+	}
+
+	retryAt := attempts.LastFailureAt.Add(g.delay(attempts.Failures))
+	if now.Before(retryAt) {
+		return &RetryError{Err: ErrTooManyAttempts, Until: retryAt}
+	}
+
+	return nil
+}
+
+func (g *LoginGuard) isStale(attempts *LoginAttempts, now time.Time) bool {
+	return attempts.IsStale(now, now.Add(-g.policy.LockoutDuration))
+}
+
+func (g *LoginGuard) delay(failures int) time.Duration {
+	extra := failures - g.policy.FreeAttempts
+	if extra <= 0 {
+		return 0
+	}
+
+	delay := g.policy.BaseDelay
+	for i := 1; i < extra && delay < g.policy.LockoutDuration; i++ {
+		delay *= 2
+	}
+
+	return min(delay, g.policy.LockoutDuration)
+}
+
+func (g *LoginGuard) maxFailures(key string) int {
+	if strings.HasPrefix(key, ipKeyPrefix) {
+		return g.policy.MaxIPFailures
+	}
+	return g.policy.MaxAccountFailures
+}
+
+func (g *LoginGuard) keys(email, ip string) []string {
+	keys := []string{accountKey(email)}
+	if ip != "" {
+		keys = append(keys, ipKeyPrefix+ip)
+	}
+	return keys
+}
+
+const (
+	accountKeyPrefix = "account:"
+	ipKeyPrefix      = "ip:"
+)
+
+func accountKey(email string) string {
+	return accountKeyPrefix + strings.ToLower(email)
+}
@@ -0,0 +1,184 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/memory"
+)
+
+var testPolicy = user.LockoutPolicy{
+	FreeAttempts:       2,
+	BaseDelay:          time.Second,
+	MaxAccountFailures: 5,
+	MaxIPFailures:      8,
+	LockoutDuration:    15 * time.Minute,
+}
+
+// testClock is a settable time source for the guard.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func newTestGuard() (*user.LoginGuard, *testClock) {
+	clock := &testClock{now: time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)}
+	guard := user.NewLoginGuard(memory.NewLoginAttemptRepository(), testPolicy).WithClock(clock.Now)
+	return guard, clock
+}
+
+func failTimes(t *testing.T, guard *user.LoginGuard, email, ip string, n int) {
+	t.Helper()
+	for range n {
+		if err := guard.RecordFailure(context.Background(), email, ip); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+	}
+}
+
+// retryUntil returns the moment a *RetryError allows the next try, failing
+// the test unless err is one wrapping want.
+func retryUntil(t *testing.T, err, want error) time.Time {
+	t.Helper()
+	var retry *user.RetryError
+	if !errors.As(err, &retry) || !errors.Is(err, want) {
+		t.Fatalf("got %v, want a retry error for %v", err, want)
+	}
+	return retry.Until
+}
+
+func TestLoginGuardBackoff(t *testing.T) {
+	tests := []struct {
+		failures  int
+		wantDelay time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		guard, clock := newTestGuard()
+		failTimes(t, guard, "ann@example.com", "", tt.failures)
+
+		err := guard.Check(context.Background(), "ann@example.com", "")
+		if tt.wantDelay == 0 {
+			if err != nil {
+				t.Errorf("%d failures: got %v, want no delay", tt.failures, err)
+			}
+			continue
+		}
+
+		until := retryUntil(t, err, user.ErrTooManyAttempts)
+		if got := until.Sub(clock.now); got != tt.wantDelay {
+			t.Errorf("%d failures: delay %v, want %v", tt.failures, got, tt.wantDelay)
+		}
+
+		clock.now = until
+		if err := guard.Check(context.Background(), "ann@example.com", ""); err != nil {
+			t.Errorf("%d failures: got %v after the delay", tt.failures, err)
+		}
+	}
+}
+
+func TestLoginGuardLockout(t *testing.T) {
+	guard, clock := newTestGuard()
+	ctx := context.Background()
+
+	failTimes(t, guard, "ann@example.com", "", testPolicy.MaxAccountFailures)
+
+	until := retryUntil(t, guard.Check(ctx, "ann@example.com", ""), user.ErrAccountLocked)
+	if want := clock.now.Add(testPolicy.LockoutDuration); !until.Equal(want) {
+		t.Errorf("locked until %v, want %v", until, want)
+	}
+
+	if err := guard.Check(ctx, "bob@example.com", ""); err != nil {
+		t.Errorf("other account: got %v, want no lock", err)
+	}
+
+	clock.now = until
+	if err := guard.Check(ctx, "ann@example.com", ""); err != nil {
+		t.Fatalf("after the lock: got %v", err)
+	}
+
+	// Counting starts over once the lock has expired.
+	failTimes(t, guard, "ann@example.com", "", 1)
+	if err := guard.Check(ctx, "ann@example.com", ""); err != nil {
+		t.Errorf("first failure after the lock: got %v", err)
+	}
+}
+
+func TestLoginGuardLocksIP(t *testing.T) {
+	guard, _ := newTestGuard()
+	ctx := context.Background()
+
+	// Spread over accounts so that none of them locks on its own.
+	emails := []string{"ann@example.com", "bob@example.com"}
+	for i := range testPolicy.MaxIPFailures {
+		failTimes(t, guard, emails[i%len(emails)], "203.0.113.7", 1)
+		if err := guard.RecordSuccess(ctx, emails[i%len(emails)]); err != nil {
+			t.Fatalf("RecordSuccess: %v", err)
+		}
+	}
+
+	retryUntil(t, guard.Check(ctx, "carol@example.com", "203.0.113.7"), user.ErrAccountLocked)
+
+	if err := guard.Check(ctx, "carol@example.com", "198.51.100.1"); err != nil {
+		t.Errorf("other IP: got %v, want no lock", err)
+	}
+}
+
+func TestLoginGuardReset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success forgets the account failures", func(t *testing.T) {
+		guard, _ := newTestGuard()
+		failTimes(t, guard, "ann@example.com", "", testPolicy.FreeAttempts+1)
+
+		if err := guard.RecordSuccess(ctx, "Ann@Example.com"); err != nil {
+			t.Fatalf("RecordSuccess: %v", err)
+		}
+		if err := guard.Check(ctx, "ann@example.com", ""); err != nil {
+			t.Errorf("got %v, want no delay", err)
+		}
+	})
+
+	t.Run("success keeps the IP failures", func(t *testing.T) {
+		guard, _ := newTestGuard()
+		failTimes(t, guard, "ann@example.com", "203.0.113.7", testPolicy.FreeAttempts+1)
+
+		if err := guard.RecordSuccess(ctx, "ann@example.com"); err != nil {
+			t.Fatalf("RecordSuccess: %v", err)
+		}
+		retryUntil(t, guard.Check(ctx, "bob@example.com", "203.0.113.7"), user.ErrTooManyAttempts)
+	})
+
+	t.Run("unlock lifts the lock", func(t *testing.T) {
+		guard, _ := newTestGuard()
+		failTimes(t, guard, "ann@example.com", "", testPolicy.MaxAccountFailures)
+
+		if err := guard.Unlock(ctx, &user.User{Email: "ann@example.com"}); err != nil {
+			t.Fatalf("Unlock: %v", err)
+		}
+		if err := guard.Check(ctx, "ann@example.com", ""); err != nil {
+			t.Errorf("got %v, want no lock", err)
+		}
+	})
+
+	t.Run("old failures are forgotten", func(t *testing.T) {
+		guard, clock := newTestGuard()
+		failTimes(t, guard, "ann@example.com", "", testPolicy.MaxAccountFailures-1)
+
+		clock.now = clock.now.Add(testPolicy.LockoutDuration)
+		failTimes(t, guard, "ann@example.com", "", 1)
+		if err := guard.Check(ctx, "ann@example.com", ""); err != nil {
+			t.Errorf("got %v, want the count to start over", err)
+		}
+	})
+}
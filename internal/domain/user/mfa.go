@@ -0,0 +1,188 @@
+package user
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	recoveryCodeCount = 10
+	// maxChallengeAttempts bounds code guessing within one MFA challenge.
+	maxChallengeAttempts = 5
+)
+
+// MFASettings holds the TOTP state of a user. A Secret without EnabledAt is
+// an enrollment waiting for confirmation.
+type MFASettings struct {
+	Secret    string
+	EnabledAt *time.Time
+	// LastStep is the TOTP time step of the last accepted code, so a code
+	// cannot be replayed.
+	LastStep int64
+	// RecoveryCodes are hashes of the unused recovery codes.
+	RecoveryCodes []string
+}
+
+func (m *MFASettings) IsEnabled() bool {
+	return m.EnabledAt != nil
+}
+
+// MFAChallenge is the second step of a login for users with MFA enabled.
+type MFAChallenge struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	UserAgent string
+	IP        string
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+func (c *MFAChallenge) IsValid() bool {
+	return c.UsedAt == nil && c.Attempts < maxChallengeAttempts && time.Now().Before(c.ExpiresAt)
+}
+
+func (c *MFAChallenge) ClientInfo() ClientInfo {
+	return ClientInfo{UserAgent: c.UserAgent, IP: c.IP}
+}
+
+func newMFAChallenge(userID uuid.UUID, client ClientInfo, duration time.Duration) (*MFAChallenge, string, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	return &MFAChallenge{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenHash: HashSecret(secret),
+		UserAgent: client.UserAgent,
+		IP:        client.IP,
+		ExpiresAt: now.Add(duration),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func NewMFAChallengeFromStorage(id, userID uuid.UUID, tokenHash, userAgent, ip string, attempts int, expiresAt, createdAt time.Time, usedAt *time.Time) *MFAChallenge {
+	return &MFAChallenge{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		UserAgent: userAgent,
+		IP:        ip,
+		Attempts:  attempts,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+		UsedAt:    usedAt,
+	}
+}
+
+func (u *User) IsMFAEnabled() bool {
+	return u.MFA.IsEnabled()
+}
+
+// MFAChanged reports whether the MFA settings changed since u was loaded.
+// Only then may the repository write them, as it must not put back codes
+// spent meanwhile by another login.
+func (u *User) MFAChanged() bool {
+	return u.mfaChanged
+}
+
+// StoredMFA returns the MFA settings as u was loaded with them.
+func (u *User) StoredMFA() MFASettings {
+	return u.storedMFA
+}
+
+func (u *User) startMFAEnrollment(secret string) error {
+	if u.MFA.IsEnabled() {
+		return ErrMFAAlreadyEnabled
+	}
+
+	u.MFA = MFASettings{Secret: secret}
+	u.mfaChanged = true
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+func (u *User) confirmMFAEnrollment(code string, recoveryHashes []string, at time.Time) error {
+	if u.MFA.IsEnabled() {
+		return ErrMFAAlreadyEnabled
+	}
+	if u.MFA.Secret == "" {
+		return ErrMFANotEnabled
+	}
+
+	step, ok := matchTOTP(u.MFA.Secret, code, at)
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	u.MFA.EnabledAt = &at
+	u.MFA.LastStep = step
+	u.MFA.RecoveryCodes = recoveryHashes
+	u.mfaChanged = true
+	u.UpdatedAt = at
+	return nil
+}
+
+func (u *User) disableMFA() {
+	u.MFA = MFASettings{}
+	u.mfaChanged = true
+	u.UpdatedAt = time.Now()
+}
+
+// verifyMFACode accepts a fresh TOTP code or an unused recovery code; a
+// recovery code is spent once it matches.
+func (u *User) verifyMFACode(code string, at time.Time) error {
+	if !u.MFA.IsEnabled() {
+		return ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+
+	if step, ok := matchTOTP(u.MFA.Secret, code, at); ok {
+		if step <= u.MFA.LastStep {
+			return ErrInvalidMFACode
+		}
+		u.MFA.LastStep = step
+		u.mfaChanged = true
+		return nil
+	}
+
+	hash := HashSecret(normalizeRecoveryCode(code))
+	for i, stored := range u.MFA.RecoveryCodes {
+		if stored == hash {
+			u.MFA.RecoveryCodes = append(u.MFA.RecoveryCodes[:i:i], u.MFA.RecoveryCodes[i+1:]...)
+			u.mfaChanged = true
+			return nil
+		}
+	}
+
+	return ErrInvalidMFACode
+}
+
+// generateRecoveryCodes returns the codes to show the user once and the
+// hashes to store.
+func generateRecoveryCodes() ([]string, []string) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		text := strings.ToLower(rand.Text())
+		codes[i] = text[:5] + "-" + text[5:10]
+		hashes[i] = HashSecret(normalizeRecoveryCode(codes[i]))
+	}
+
+	return codes, hashes
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(code, "-", ""))
+}
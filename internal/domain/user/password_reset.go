@@ -0,0 +1,52 @@
+package user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+func (t *PasswordResetToken) IsValid() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
+
+func (t *PasswordResetToken) use(at time.Time) {
+	t.UsedAt = &at
+}
+
+func newPasswordResetToken(userID uuid.UUID, duration time.Duration) (*PasswordResetToken, string, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	return &PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenHash: HashSecret(secret),
+		ExpiresAt: now.Add(duration),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func NewPasswordResetTokenFromStorage(id, userID uuid.UUID, tokenHash string, expiresAt, createdAt time.Time, usedAt *time.Time) *PasswordResetToken {
+	return &PasswordResetToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+		UsedAt:    usedAt,
+	}
+}
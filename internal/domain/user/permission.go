@@ -0,0 +1,67 @@
+package user
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type Permission string
+
+const (
+	PermissionUsersRead     Permission = "users:read"
+	PermissionUsersWrite    Permission = "users:write"
+	PermissionCoursesManage Permission = "courses:manage"
+	// PermissionCoursesAdmin extends courses:manage to content owned by others.
+	PermissionCoursesAdmin Permission = "courses:admin"
+	// PermissionUsersReadAll extends users:read from the actor's own
+	// students to every user.
+	PermissionUsersReadAll Permission = "users:read_all"
+	PermissionRolesManage  Permission = "roles:manage"
+	PermissionAuditRead    Permission = "audit:read"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionUsersRead, PermissionUsersReadAll, PermissionUsersWrite, PermissionCoursesManage, PermissionCoursesAdmin,
+		PermissionRolesManage, PermissionAuditRead,
+	},
+	RoleMentor:  {PermissionUsersRead, PermissionCoursesManage},
+	RoleStudent: {},
+}
+
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+func (r Role) Can(p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
+
+// Actor is the authenticated user a use case runs on behalf of, and the
+// client they act from.
+type Actor struct {
+	ID     uuid.UUID
+	Role   Role
+	Client ClientInfo
+}
+
+// SystemActor runs operator commands such as the users CLI, which already
+// have direct access to the database.
+var SystemActor = Actor{Role: RoleAdmin}
+
+func (a Actor) Authorize(p Permission) error {
+	if !a.Role.Can(p) {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// AuthorizeUser lets every user act on their own account and requires p to
+// act on anyone else's.
+func (a Actor) AuthorizeUser(target uuid.UUID, p Permission) error {
+	if a.ID == target {
+		return nil
+	}
+	return a.Authorize(p)
+}
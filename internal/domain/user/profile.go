@@ -0,0 +1,118 @@
+package user
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxDisplayNameLength = 64
+	maxBioLength         = 500
+)
+
+// Locale is the interface language of a user; the frontend ships en and ru.
+type Locale string
+
+const (
+	LocaleEN Locale = "en"
+	LocaleRU Locale = "ru"
+)
+
+const DefaultLocale = LocaleEN
+
+func (l Locale) IsValid() bool {
+	switch l {
+	case LocaleEN, LocaleRU:
+		return true
+	default:
+		return false
+	}
+}
+
+// Profile holds what users tell about themselves beyond their name.
+type Profile struct {
+	DisplayName string
+	// AvatarKey locates the avatar image in the file storage; AvatarURL is
+	// where clients load it from. Both are empty without an avatar.
+	AvatarKey string
+	AvatarURL string
+	Locale    Locale
+	// Timezone is an IANA name such as "Europe/Moscow".
+	Timezone string
+	Bio      string
+}
+
+func defaultProfile() Profile {
+	return Profile{Locale: DefaultLocale, Timezone: "UTC"}
+}
+
+// ProfileChanges is a partial profile update; nil fields are left as they are.
+type ProfileChanges struct {
+	DisplayName *string
+	Locale      *string
+	Timezone    *string
+	Bio         *string
+}
+
+func (u *User) updateDetails(changes ProfileChanges) error {
+	profile := u.Profile
+
+	if changes.DisplayName != nil {
+		name := strings.TrimSpace(*changes.DisplayName)
+		if utf8.RuneCountInString(name) > maxDisplayNameLength {
+			return ErrInvalidDisplayName
+		}
+		profile.DisplayName = name
+	}
+
+	if changes.Locale != nil {
+		locale := Locale(*changes.Locale)
+		if !locale.IsValid() {
+			return ErrInvalidLocale
+		}
+		profile.Locale = locale
+	}
+
+	if changes.Timezone != nil {
+		if !isValidTimezone(*changes.Timezone) {
+			return ErrInvalidTimezone
+		}
+		profile.Timezone = *changes.Timezone
+	}
+
+	if changes.Bio != nil {
+		bio := strings.TrimSpace(*changes.Bio)
+		if utf8.RuneCountInString(bio) > maxBioLength {
+			return ErrBioTooLong
+		}
+		profile.Bio = bio
+	}
+
+	if profile != u.Profile {
+		u.Profile = profile
+		u.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// setAvatar replaces the avatar and returns the key of the previous one, if
+// any, so the caller can remove the file.
+func (u *User) setAvatar(key, url string) string {
+	previous := u.Profile.AvatarKey
+	u.Profile.AvatarKey = key
+	u.Profile.AvatarURL = url
+	u.UpdatedAt = time.Now()
+	return previous
+}
+
+// isValidTimezone accepts IANA names only; LoadLocation also takes "" and
+// "Local", which depend on the server.
+func isValidTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
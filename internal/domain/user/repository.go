@@ -2,14 +2,22 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Repository interface {
+	// Every finder except FindByIDIncludingDeleted skips soft-deleted users.
 	FindByID(ctx context.Context, id uuid.UUID) (*User, error)
 
-	FindAll(ctx context.Context) ([]*User, error)
+	FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*User, error)
+
+	List(ctx context.Context, query ListQuery) (*UserPage, error)
+
+	// Search ranks users by typo-tolerant similarity of the query to their
+	// name and email, best match first.
+	Search(ctx context.Context, query SearchQuery) ([]*SearchResult, error)
 
 	FindByEmail(ctx context.Context, email string) (*User, error)
 
@@ -17,7 +25,90 @@ type Repository interface {
 
 	Save(ctx context.Context, user *User) error
 
+	// SaveAll stores the users atomically: on error none of them is saved.
+	SaveAll(ctx context.Context, users []*User) error
+
+	// Update stores the user if it is still at user.Version and then bumps
+	// the version; otherwise it returns ErrVersionConflict. The MFA settings
+	// are only written when User.MFAChanged, and User.RoleChanges are
+	// recorded in the same transaction. A user that LeavesAdmins while no
+	// other active admin remains is refused with ErrLastAdmin.
 	Update(ctx context.Context, user *User) error
 
+	// UpdateSessions stores only the refresh tokens and the MFA replay state
+	// of the user. It neither checks nor bumps the version, so signing in
+	// does not conflict with edits of the account. Revoking a token that is
+	// no longer active returns ErrVersionConflict, as does Update; MFA state
+	// that changed since the user was loaded returns ErrInvalidMFACode.
+	UpdateSessions(ctx context.Context, user *User) error
+
+	// Delete removes the user permanently; soft deletion goes through Update.
+	// Deleting the last active admin returns ErrLastAdmin.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// PurgeExpiredTokens removes the refresh token families whose newest
+	// token expired before now and returns how many tokens there were.
+	PurgeExpiredTokens(ctx context.Context, now time.Time) (int, error)
+
+	// PurgeDeleted permanently removes users soft-deleted before the cutoff
+	// and returns them.
+	PurgeDeleted(ctx context.Context, before time.Time) ([]PurgedUser, error)
+}
+
+// PurgedUser is what is left to clean up after a purged user.
+type PurgedUser struct {
+	ID        uuid.UUID
+	AvatarKey string
+}
+
+type PasswordResetRepository interface {
+	Save(ctx context.Context, token *PasswordResetToken) error
+
+	FindByHash(ctx context.Context, hash string) (*PasswordResetToken, error)
+
+	// Consume marks the token and every other pending token of its user as
+	// used. It returns ErrInvalidResetToken when the token was already used.
+	Consume(ctx context.Context, token *PasswordResetToken) error
+}
+
+type EmailVerificationRepository interface {
+	Save(ctx context.Context, token *EmailVerificationToken) error
+
+	FindByHash(ctx context.Context, hash string) (*EmailVerificationToken, error)
+
+	// Consume marks the token and every other pending token of its user as used.
+	Consume(ctx context.Context, token *EmailVerificationToken) error
+}
+
+type LoginAttemptRepository interface {
+	// Find returns nil when there are no recorded failures for the key.
+	Find(ctx context.Context, key string) (*LoginAttempts, error)
+
+	// Increment atomically records a failure at the given time and returns
+	// the updated attempts. Stale attempts (see LoginAttempts.IsStale) start
+	// over from one failure.
+	Increment(ctx context.Context, key string, at, forgetBefore time.Time) (*LoginAttempts, error)
+
+	Lock(ctx context.Context, key string, until time.Time) error
+
+	Delete(ctx context.Context, key string) error
+}
+
+type MFAChallengeRepository interface {
+	Save(ctx context.Context, challenge *MFAChallenge) error
+
+	FindByHash(ctx context.Context, hash string) (*MFAChallenge, error)
+
+	// Consume marks the challenge used. It returns ErrInvalidMFAChallenge
+	// when the challenge was already used, so it completes only one login.
+	Consume(ctx context.Context, challenge *MFAChallenge) error
+
+	// AddFailure atomically counts a wrong code against an unused challenge.
+	AddFailure(ctx context.Context, challenge *MFAChallenge) error
+}
+
+// RoleChangeRepository reads the role history; Repository.Update records it.
+type RoleChangeRepository interface {
+	// FindByUser returns the changes of one user, newest first.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*RoleChange, error)
 }
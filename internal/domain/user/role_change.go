@@ -0,0 +1,42 @@
+package user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleChange is the audit record of one role assignment.
+type RoleChange struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	ActorID   uuid.UUID
+	OldRole   Role
+	NewRole   Role
+	Reason    string
+	ChangedAt time.Time
+}
+
+func newRoleChange(u *User, actor Actor, oldRole Role, reason string) *RoleChange {
+	return &RoleChange{
+		ID:        uuid.New(),
+		UserID:    u.ID,
+		ActorID:   actor.ID,
+		OldRole:   oldRole,
+		NewRole:   u.Role,
+		Reason:    reason,
+		ChangedAt: u.UpdatedAt,
+	}
+}
+
+func NewRoleChangeFromStorage(id, userID, actorID uuid.UUID, oldRole, newRole Role, reason string, changedAt time.Time) *RoleChange {
+	return &RoleChange{
+		ID:        id,
+		UserID:    userID,
+		ActorID:   actorID,
+		OldRole:   oldRole,
+		NewRole:   newRole,
+		Reason:    reason,
+		ChangedAt: changedAt,
+	}
+}
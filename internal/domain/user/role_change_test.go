@@ -0,0 +1,84 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+)
+
+func newAdmins(t *testing.T, n int) (*user.Service, user.Repository, []uuid.UUID) {
+	t.Helper()
+	repo := memory.NewUserRepository()
+	s := user.NewService(repo, plainHasher{}, user.Policy{DeletionRetention: time.Hour})
+
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+		u := user.NewUserFromStorage(ids[i], ids[i].String()+"@example.com", "Ann", "Lee", "secret", user.RoleAdmin,
+			nil, user.MFASettings{}, user.Profile{}, time.Now(), time.Now(), nil, 1, nil)
+		if err := repo.Save(context.Background(), u); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	return s, repo, ids
+}
+
+func load(t *testing.T, repo user.Repository, id uuid.UUID) *user.User {
+	t.Helper()
+	u, err := repo.FindByID(context.Background(), id)
+	if err != nil || u == nil {
+		t.Fatalf("FindByID: %v, %v", u, err)
+	}
+	return u
+}
+
+func TestChangeRoleKeepsAnAdmin(t *testing.T) {
+	s, repo, ids := newAdmins(t, 2)
+	ctx := context.Background()
+	actor := user.Actor{ID: uuid.New(), Role: user.RoleAdmin}
+
+	// Both demotions start from two admins, as two concurrent requests do.
+	first, second := load(t, repo, ids[0]), load(t, repo, ids[1])
+	for _, u := range []*user.User{first, second} {
+		if _, err := s.ChangeRole(ctx, actor, u, user.RoleMentor, "rotation"); err != nil {
+			t.Fatalf("ChangeRole: %v", err)
+		}
+	}
+
+	if got := len(first.RoleChanges()); got != 1 {
+		t.Fatalf("%d role changes to record, want 1", got)
+	}
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("first demotion: %v", err)
+	}
+	if len(first.RoleChanges()) != 0 {
+		t.Error("the stored role change would be recorded again")
+	}
+
+	if err := repo.Update(ctx, second); !errors.Is(err, user.ErrLastAdmin) {
+		t.Errorf("second demotion: got %v, want %v", err, user.ErrLastAdmin)
+	}
+}
+
+func TestDeleteUserKeepsAnAdmin(t *testing.T) {
+	s, repo, ids := newAdmins(t, 1)
+	ctx := context.Background()
+
+	u := load(t, repo, ids[0])
+	if err := s.DeleteUser(ctx, u); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if err := repo.Update(ctx, u); !errors.Is(err, user.ErrLastAdmin) {
+		t.Errorf("soft delete: got %v, want %v", err, user.ErrLastAdmin)
+	}
+
+	if err := repo.Delete(ctx, ids[0]); !errors.Is(err, user.ErrLastAdmin) {
+		t.Errorf("erase: got %v, want %v", err, user.ErrLastAdmin)
+	}
+}
@@ -0,0 +1,133 @@
+package user
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// SearchThreshold is the minimum trigram similarity between a query term and
+// a word for the word to count as a typo-tolerant match.
+const SearchThreshold = 0.3
+
+type SearchQuery struct {
+	// IDs limits the search to these users when not nil.
+	IDs   []uuid.UUID
+	Text  string
+	Role  Role
+	Limit int
+}
+
+// SearchResult is a matched user with a relevance score between 0 and 1.
+type SearchResult struct {
+	User  *User
+	Score float64
+}
+
+// Match is the byte range of a word in a field that matched the query.
+type Match struct {
+	Start int
+	End   int
+}
+
+// MatchWords finds the words of text that contain a query term or are
+// similar enough to one, so results can be highlighted the same way whichever
+// repository ranked them.
+func MatchWords(text, query string) []Match {
+	terms := searchWords(query)
+	matches := make([]Match, 0)
+
+	for _, w := range wordSpans(text) {
+		word := strings.ToLower(text[w.Start:w.End])
+		for _, term := range terms {
+			if strings.Contains(word, term) || TrigramSimilarity(term, word) >= SearchThreshold {
+				matches = append(matches, w)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// WordSimilarity scores text against query: the mean over query terms of the
+// best similarity any word of text reaches, with containment counting as 1.
+func WordSimilarity(query, text string) float64 {
+	terms := searchWords(query)
+	words := searchWords(text)
+	if len(terms) == 0 || len(words) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, term := range terms {
+		best := 0.0
+		for _, word := range words {
+			score := TrigramSimilarity(term, word)
+			if strings.Contains(word, term) {
+				score = 1
+			}
+			best = max(best, score)
+		}
+		total += best
+	}
+
+	return total / float64(len(terms))
+}
+
+// TrigramSimilarity mirrors pg_trgm's similarity(): the share of trigrams the
+// two padded, lower-cased words have in common.
+func TrigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	common := 0
+	for t := range ta {
+		if tb[t] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(ta)+len(tb)-common)
+}
+
+func trigrams(word string) map[string]bool {
+	runes := []rune("  " + strings.ToLower(word) + " ")
+	set := make(map[string]bool, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = true
+	}
+	return set
+}
+
+func searchWords(s string) []string {
+	words := make([]string, 0)
+	for _, w := range wordSpans(s) {
+		words = append(words, strings.ToLower(s[w.Start:w.End]))
+	}
+	return words
+}
+
+// wordSpans splits s on anything that is not a letter or digit, the way
+// pg_trgm does.
+func wordSpans(s string) []Match {
+	spans := make([]Match, 0)
+	start := -1
+	for i, r := range s {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWord && start < 0 {
+			start = i
+		}
+		if !isWord && start >= 0 {
+			spans = append(spans, Match{Start: start, End: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, Match{Start: start, End: len(s)})
+	}
+	return spans
+}
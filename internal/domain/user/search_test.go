@@ -0,0 +1,68 @@
+package user_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"trainer/internal/domain/user"
+)
+
+func TestTrigramSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"ann", "ann", 1},
+		{"Ann", "aNN", 1},
+		{"abc", "abd", 2.0 / 6},
+		{"jon", "john", 2.0 / 7},
+		{"ann", "bob", 0},
+		{"", "ann", 0},
+	}
+
+	for _, tt := range tests {
+		if got := user.TrigramSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("TrigramSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestWordSimilarity(t *testing.T) {
+	tests := []struct {
+		query, text string
+		want        float64
+	}{
+		{"ann", "ann@example.com", 1},
+		{"an", "Ann Lee", 1},
+		{"jon smith", "John Smith", (2.0/7 + 1) / 2},
+		{"anne", "Ann Lee", 0.5},
+		{"ann", "Bob Ray", 0},
+		{"", "Ann Lee", 0},
+		{"ann", "", 0},
+	}
+
+	for _, tt := range tests {
+		if got := user.WordSimilarity(tt.query, tt.text); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("WordSimilarity(%q, %q) = %v, want %v", tt.query, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMatchWords(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        []user.Match
+	}{
+		{"Ann Lee", "an", []user.Match{{Start: 0, End: 3}}},
+		{"Ann Lee", "lee ann", []user.Match{{Start: 0, End: 3}, {Start: 4, End: 7}}},
+		{"ann@example.com", "exampel", []user.Match{{Start: 4, End: 11}}},
+		{"Zoë Brandt", "zoe", []user.Match{{Start: 0, End: 4}}},
+		{"Bob Ray", "ann", []user.Match{}},
+	}
+
+	for _, tt := range tests {
+		if got := user.MatchWords(tt.text, tt.query); !slices.Equal(got, tt.want) {
+			t.Errorf("MatchWords(%q, %q) = %v, want %v", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
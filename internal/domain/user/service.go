@@ -2,6 +2,9 @@ package user
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,38 +15,169 @@ type PasswordHasher interface {
 	Compare(hash, password string) bool
 }
 
+// VerificationPolicy decides what an account with an unconfirmed email may do.
+type VerificationPolicy string
+
+const (
+	// VerificationOff lets unverified accounts log in as usual.
+	VerificationOff VerificationPolicy = "off"
+	// VerificationRequired refuses to log in unverified accounts.
+	VerificationRequired VerificationPolicy = "required"
+	// VerificationRestricted logs unverified accounts in with RoleStudent
+	// rights whatever their actual role is.
+	VerificationRestricted VerificationPolicy = "restricted"
+)
+
+func (p VerificationPolicy) IsValid() bool {
+	switch p {
+	case VerificationOff, VerificationRequired, VerificationRestricted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Policy holds the lifetimes of the tokens issued by the Service and the
+// rules it enforces on login.
+type Policy struct {
+	RefreshTokenTTL      time.Duration
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	EmailVerification    VerificationPolicy
+	MFAChallengeTTL      time.Duration
+	// MFAIssuer is the account issuer shown by authenticator apps.
+	MFAIssuer string
+	// MFARequiredRoles lists roles that only get their rights once TOTP is
+	// enabled; until then they are treated as RoleStudent.
+	MFARequiredRoles []Role
+	// DeletionRetention is how long a soft-deleted account can be restored
+	// before it is purged.
+	DeletionRetention time.Duration
+}
+
 type Service struct {
-	repo            Repository
-	hasher          PasswordHasher
-	refreshDuration time.Duration
+	repo   Repository
+	hasher PasswordHasher
+	policy Policy
+
+	dummyHashOnce sync.Once
+	dummyHash     string
 }
 
-func NewService(repo Repository, hasher PasswordHasher, refreshDuration time.Duration) *Service {
+func NewService(repo Repository, hasher PasswordHasher, policy Policy) *Service {
 	return &Service{
-		repo:            repo,
-		hasher:          hasher,
-		refreshDuration: refreshDuration,
+		repo:   repo,
+		hasher: hasher,
+		policy: policy,
 	}
 }
 
+// Login returns ErrInvalidCredentials both for an unknown email and a wrong
+// password, and takes as long for either, so it does not tell which
+// addresses have an account.
 func (s *Service) Login(ctx context.Context, email, password string) (*User, error) {
 	user, err := s.repo.FindByEmail(ctx, email)
 	if err != nil {
-		return nil, ErrNotFound
+		return nil, err
 	}
 
 	if user == nil {
-		return nil, ErrNotFound
+		s.hasher.Compare(s.unknownUserHash(), password)
+		return nil, ErrInvalidCredentials
 	}
 
 	if checkPassword := s.checkPassword(user, password); !checkPassword {
-		return nil, ErrInvalidPassword
+		return nil, ErrInvalidCredentials
+	}
+
+	if s.policy.EmailVerification == VerificationRequired && !user.IsEmailVerified() {
+		return nil, ErrEmailNotVerified
 	}
 
 	return user, nil
 }
 
-func (s *Service) NewUser(ctx context.Context, email, firstName, lastName, password, role string) (*User, error) {
+// ClaimRole is the role to put into tokens issued for u.
+func (s *Service) ClaimRole(u *User) Role {
+	if s.policy.EmailVerification == VerificationRestricted && !u.IsEmailVerified() {
+		return RoleStudent
+	}
+
+	if s.MFAEnrollmentRequired(u) {
+		return RoleStudent
+	}
+
+	return u.Role
+}
+
+// MFAEnrollmentRequired reports whether the role of u demands MFA that u has
+// not set up yet.
+func (s *Service) MFAEnrollmentRequired(u *User) bool {
+	return !u.IsMFAEnabled() && slices.Contains(s.policy.MFARequiredRoles, u.Role)
+}
+
+// StartTOTPEnrollment generates a new TOTP secret for u, replacing any
+// unconfirmed one, and returns it with the otpauth URI for QR codes.
+func (s *Service) StartTOTPEnrollment(ctx context.Context, u *User) (string, string, error) {
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := u.startMFAEnrollment(secret); err != nil {
+		return "", "", err
+	}
+
+	return secret, totpURI(s.policy.MFAIssuer, u.Email, secret), nil
+}
+
+// ConfirmTOTPEnrollment enables MFA once the user proves the authenticator
+// works, and returns the recovery codes to show them once.
+func (s *Service) ConfirmTOTPEnrollment(ctx context.Context, u *User, code string) ([]string, error) {
+	codes, hashes := generateRecoveryCodes()
+
+	if err := u.confirmMFAEnrollment(code, hashes, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (s *Service) DisableTOTP(ctx context.Context, u *User, code string) error {
+	if slices.Contains(s.policy.MFARequiredRoles, u.Role) {
+		return ErrMFARequired
+	}
+
+	if err := u.verifyMFACode(code, time.Now()); err != nil {
+		return err
+	}
+
+	u.disableMFA()
+	return nil
+}
+
+func (s *Service) NewMFAChallenge(ctx context.Context, u *User, client ClientInfo) (*MFAChallenge, string, error) {
+	return newMFAChallenge(u.ID, client, s.policy.MFAChallengeTTL)
+}
+
+// CompleteMFAChallenge checks the second factor. Failed codes count against
+// the challenge, which stops accepting codes after a few attempts.
+func (s *Service) CompleteMFAChallenge(ctx context.Context, u *User, challenge *MFAChallenge, code string) error {
+	if challenge.UserID != u.ID || !challenge.IsValid() {
+		return ErrInvalidMFAChallenge
+	}
+
+	now := time.Now()
+	if err := u.verifyMFACode(code, now); err != nil {
+		challenge.Attempts++
+		return err
+	}
+
+	challenge.UsedAt = &now
+	return nil
+}
+
+func (s *Service) NewUser(ctx context.Context, email, firstName, lastName, password string, role Role) (*User, error) {
 	existing, _ := s.repo.FindByEmail(ctx, email)
 	if existing != nil {
 		return nil, ErrEmailAlreadyUsed
@@ -54,13 +188,14 @@ func (s *Service) NewUser(ctx context.Context, email, firstName, lastName, passw
 		return nil, ErrInvalidPassword
 	}
 
-	return newUser(email, firstName, lastName, hashedPassword, RoleMentor)
+	return newUser(email, firstName, lastName, hashedPassword, role)
 }
 
+// UpdateUser applies the non-empty fields. A new email has to be verified
+// again.
 func (s *Service) UpdateUser(user *User, firstName, lastName, email, password string) error {
-	err := user.updateProfile(firstName, lastName, email)
-	if err != nil {
-		return ErrFailedUpdate
+	if err := user.updateProfile(firstName, lastName, email); err != nil {
+		return err
 	}
 
 	if password != "" {
@@ -77,28 +212,267 @@ func (s *Service) UpdateUser(user *User, firstName, lastName, email, password st
 	return nil
 }
 
-func (s *Service) CreateRefreshToken(ctx context.Context, u *User) (*RefreshToken, error) {
-	newToken, err := newRefreshToken(s.refreshDuration)
+// UpdateProfileDetails applies a partial profile update; nothing changes
+// when a field is invalid.
+func (s *Service) UpdateProfileDetails(u *User, changes ProfileChanges) error {
+	return u.updateDetails(changes)
+}
+
+// SetAvatar points u at a newly stored avatar, or removes it when key is
+// empty. It returns the key of the replaced file.
+func (s *Service) SetAvatar(u *User, key, url string) string {
+	return u.setAvatar(key, url)
+}
+
+// ChangePassword sets a new password once the current one is confirmed and
+// signs u out of every session except the one making the change.
+func (s *Service) ChangePassword(ctx context.Context, u *User, currentPassword, newPassword string, currentSession uuid.UUID) error {
+	if !s.checkPassword(u, currentPassword) {
+		return ErrIncorrectPassword
+	}
+
+	if err := s.UpdateUser(u, "", "", "", newPassword); err != nil {
+		return err
+	}
+
+	u.revokeOtherSessions(currentSession)
+
+	return nil
+}
+
+// ChangeEmail asks to move u to a new address once the password is
+// confirmed. The current address stays in use until VerifyEmail confirms the
+// new one; asking for the current address cancels a pending change.
+func (s *Service) ChangeEmail(ctx context.Context, u *User, email, password string) error {
+	if !s.checkPassword(u, password) {
+		return ErrIncorrectPassword
+	}
+
+	if email == u.Email {
+		u.cancelEmailChange()
+		return nil
+	}
+
+	if err := s.ensureEmailFree(ctx, email); err != nil {
+		return err
+	}
+
+	return u.requestEmailChange(email)
+}
+
+func (s *Service) ensureEmailFree(ctx context.Context, email string) error {
+	existing, err := s.repo.FindByEmail(ctx, email)
 	if err != nil {
-		return nil, ErrTokenRefresh
+		return err
+	}
+	if existing != nil {
+		return ErrEmailAlreadyUsed
 	}
+	return nil
+}
 
-	err = u.addRefreshToken(newToken)
+// DeleteOwnAccount soft-deletes the account of the caller once the password
+// is confirmed.
+func (s *Service) DeleteOwnAccount(ctx context.Context, u *User, password string) error {
+	if !s.checkPassword(u, password) {
+		return ErrIncorrectPassword
+	}
+
+	return s.DeleteUser(ctx, u)
+}
+
+// ChangeRole assigns role to u on behalf of actor. Nobody may raise their own
+// role, and the repository refuses to demote the last admin. It returns nil
+// when the role is unchanged.
+func (s *Service) ChangeRole(ctx context.Context, actor Actor, u *User, role Role, reason string) (*RoleChange, error) {
+	if err := actor.Authorize(PermissionRolesManage); err != nil {
+		return nil, err
+	}
+
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	if u.Role == role {
+		return nil, nil
+	}
+
+	if actor.ID == u.ID && role.rank() > u.Role.rank() {
+		return nil, ErrSelfPromotion
+	}
+
+	oldRole := u.Role
+	u.changeRole(role)
+
+	change := newRoleChange(u, actor, oldRole, reason)
+	u.roleChanges = append(u.roleChanges, change)
+
+	return change, nil
+}
+
+// DeleteUser soft-deletes u and ends its sessions. The account stays
+// restorable for the retention period.
+func (s *Service) DeleteUser(ctx context.Context, u *User) error {
+	if u.IsDeleted() {
+		return ErrUserNotFound
+	}
+
+	u.revokeAllSessions()
+	u.markDeleted(time.Now())
+
+	return nil
+}
+
+func (s *Service) RestoreUser(ctx context.Context, u *User) error {
+	if !u.IsDeleted() {
+		return ErrUserNotDeleted
+	}
+
+	if time.Since(*u.DeletedAt) > s.policy.DeletionRetention {
+		return ErrRestoreExpired
+	}
+
+	// The address may have been registered again since the deletion.
+	existing, err := s.repo.FindByEmail(ctx, u.Email)
 	if err != nil {
-		return nil, ErrTokenRefresh
+		return err
+	}
+	if existing != nil {
+		return ErrEmailAlreadyUsed
 	}
 
-	return newToken, nil
+	u.restore()
+
+	return nil
 }
 
-func (s *Service) RenewRefreshToken(ctx context.Context, u *User, token uuid.UUID) (*RefreshToken, error) {
+// PurgeCutoff is the deletion time before which accounts are past retention.
+func (s *Service) PurgeCutoff(now time.Time) time.Time {
+	return now.Add(-s.policy.DeletionRetention)
+}
+
+// CreateRefreshToken starts a new token family, one per login.
+func (s *Service) CreateRefreshToken(ctx context.Context, u *User, client ClientInfo) (*RefreshToken, error) {
+	return s.issueRefreshToken(u, uuid.New(), client)
+}
+
+// RenewRefreshToken rotates the given token within its family. Presenting a
+// token that was already rotated means it leaked, so the whole family is
+// revoked and ErrRefreshTokenReused is returned; the caller must persist u.
+func (s *Service) RenewRefreshToken(ctx context.Context, u *User, token uuid.UUID, client ClientInfo) (*RefreshToken, error) {
+	current := u.findRefreshToken(token)
+	if current == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
 	err := u.revokeRefreshToken(token)
+	if errors.Is(err, ErrRefreshTokenReused) {
+		u.revokeTokenFamily(current.FamilyID)
+		return nil, ErrRefreshTokenReused
+	}
 	if err != nil {
-		return nil, ErrTokenRefresh
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return s.issueRefreshToken(u, current.FamilyID, client)
+}
+
+// Logout ends the session the given refresh token belongs to.
+func (s *Service) Logout(ctx context.Context, u *User, token uuid.UUID) error {
+	current := u.findRefreshToken(token)
+	if current == nil {
+		return ErrInvalidRefreshToken
 	}
 
-	newToken, err := s.CreateRefreshToken(ctx, u)
+	u.revokeTokenFamily(current.FamilyID)
+	return nil
+}
 
+func (s *Service) RevokeSession(ctx context.Context, u *User, sessionID uuid.UUID) error {
+	return u.revokeSession(sessionID)
+}
+
+func (s *Service) RevokeAllSessions(ctx context.Context, u *User) {
+	u.revokeAllSessions()
+}
+
+// RequestPasswordReset issues a single-use reset token. The returned secret
+// is sent to the user; only its hash is kept in the token.
+func (s *Service) RequestPasswordReset(ctx context.Context, u *User) (*PasswordResetToken, string, error) {
+	return newPasswordResetToken(u.ID, s.policy.PasswordResetTTL)
+}
+
+// ResetPassword sets a new password using a reset token, consumes the token
+// and signs the user out everywhere.
+func (s *Service) ResetPassword(ctx context.Context, u *User, token *PasswordResetToken, password string) error {
+	if token.UserID != u.ID || !token.IsValid() {
+		return ErrInvalidResetToken
+	}
+
+	hashedPassword, err := s.hasher.Hash(password)
+	if err != nil {
+		return ErrInvalidPassword
+	}
+
+	if err := u.updatePassword(hashedPassword); err != nil {
+		return err
+	}
+
+	token.use(time.Now())
+	u.revokeAllSessions()
+
+	return nil
+}
+
+func (s *Service) RequestEmailVerification(ctx context.Context, u *User) (*EmailVerificationToken, string, error) {
+	if u.PendingEmail != "" {
+		return newEmailVerificationToken(u.ID, u.PendingEmail, s.policy.EmailVerificationTTL)
+	}
+
+	if u.IsEmailVerified() {
+		return nil, "", ErrEmailVerified
+	}
+
+	return newEmailVerificationToken(u.ID, u.Email, s.policy.EmailVerificationTTL)
+}
+
+// VerifyEmail confirms the address the token was sent to, provided the user
+// still has that address or is moving to it. A confirmed pending address
+// replaces the current one.
+func (s *Service) VerifyEmail(ctx context.Context, u *User, token *EmailVerificationToken) error {
+	if token.UserID != u.ID || !token.IsValid() {
+		return ErrInvalidVerification
+	}
+
+	now := time.Now()
+
+	switch {
+	case u.PendingEmail != "" && token.Email == u.PendingEmail:
+		// The address may have been taken since the change was asked for.
+		if err := s.ensureEmailFree(ctx, token.Email); err != nil {
+			return err
+		}
+		token.use(now)
+		u.confirmEmailChange(now)
+	case token.Email == u.Email:
+		token.use(now)
+		if !u.IsEmailVerified() {
+			u.markEmailVerified(now)
+		}
+	default:
+		return ErrInvalidVerification
+	}
+
+	return nil
+}
+
+func (s *Service) issueRefreshToken(u *User, familyID uuid.UUID, client ClientInfo) (*RefreshToken, error) {
+	newToken, err := newRefreshToken(s.policy.RefreshTokenTTL, familyID, client)
+	if err != nil {
+		return nil, ErrTokenRefresh
+	}
+
+	err = u.addRefreshToken(newToken)
 	if err != nil {
 		return nil, ErrTokenRefresh
 	}
@@ -109,3 +483,12 @@ func (s *Service) RenewRefreshToken(ctx context.Context, u *User, token uuid.UUI
 func (s *Service) checkPassword(user *User, password string) bool {
 	return s.hasher.Compare(user.Password, password)
 }
+
+// unknownUserHash is a hash made with the configured cost to compare against
+// when there is no user to check the password of.
+func (s *Service) unknownUserHash() string {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = s.hasher.Hash(uuid.NewString())
+	})
+	return s.dummyHash
+}
@@ -2,36 +2,78 @@ package user
 
 import (
 	"regexp"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type User struct {
-	ID            uuid.UUID
-	FirstName     string
-	LastName      string
-	Email         string
-	Password      string
-	Role          Role
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID              uuid.UUID
+	FirstName       string
+	LastName        string
+	Email           string
+	Password        string
+	Role            Role
+	EmailVerifiedAt *time.Time
+	// PendingEmail is the address u asked to move to. Email stays in use
+	// until the link sent to the new address is opened.
+	PendingEmail string
+	MFA          MFASettings
+	Profile      Profile
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+	// Version counts the stored revisions of the user. The repository
+	// refuses an update made from an outdated version.
+	Version       int
 	refreshTokens map[uuid.UUID]*RefreshToken
 	revokedTokens map[uuid.UUID]*RefreshToken
+	// newTokens and newlyRevoked are the tokens issued and revoked since u
+	// was loaded.
+	newTokens     []*RefreshToken
+	newlyRevoked  []*RefreshToken
+	endedSessions []uuid.UUID
+	// storedMFA is MFA as loaded; mfaChanged tells whether u changed it since.
+	storedMFA  MFASettings
+	mfaChanged bool
+	// roleChanges are the role assignments made since u was loaded;
+	// storedAdmin tells whether u was an active admin then.
+	roleChanges []*RoleChange
+	storedAdmin bool
 }
 
 type RefreshToken struct {
 	ID        uuid.UUID
 	userID    uuid.UUID
-	Revoked   bool
+	FamilyID  uuid.UUID
+	UserAgent string
+	IP        string
 	ExpiresAt time.Time
 	CreatedAt time.Time
+	RevokedAt *time.Time
 }
 
 func (rt *RefreshToken) IsExpired() bool {
 	return time.Now().After(rt.ExpiresAt)
 }
 
+func (rt *RefreshToken) IsRevoked() bool {
+	return rt.RevokedAt != nil
+}
+
+func (rt *RefreshToken) revoke(at time.Time) {
+	if rt.RevokedAt == nil {
+		rt.RevokedAt = &at
+	}
+}
+
+// ClientInfo describes the device a refresh token was issued to.
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
 type Role string
 
 const (
@@ -62,8 +104,10 @@ func newUser(email, firstName, lastName, hashedPassword string, role Role) (*Use
 		Email:         email,
 		Password:      hashedPassword,
 		Role:          role,
+		Profile:       defaultProfile(),
 		CreatedAt:     now,
 		UpdatedAt:     now,
+		Version:       1,
 		refreshTokens: make(map[uuid.UUID]*RefreshToken),
 		revokedTokens: make(map[uuid.UUID]*RefreshToken),
 	}, nil
@@ -84,6 +128,8 @@ func (u *User) updateProfile(firstName, lastName, email string) error {
 		}
 
 		u.Email = email
+		u.PendingEmail = ""
+		u.markEmailUnverified()
 	}
 
 	u.UpdatedAt = time.Now()
@@ -91,6 +137,11 @@ func (u *User) updateProfile(firstName, lastName, email string) error {
 	return nil
 }
 
+func (u *User) changeRole(role Role) {
+	u.Role = role
+	u.UpdatedAt = time.Now()
+}
+
 func (u *User) updatePassword(newHashedPassword string) error {
 	if newHashedPassword == "" {
 		return ErrEmptyPassword
@@ -102,26 +153,171 @@ func (u *User) updatePassword(newHashedPassword string) error {
 	return nil
 }
 
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) markEmailVerified(at time.Time) {
+	u.EmailVerifiedAt = &at
+	u.UpdatedAt = at
+}
+
+func (u *User) requestEmailChange(email string) error {
+	if err := validateEmail(email); err != nil {
+		return ErrInvalidEmail
+	}
+
+	u.PendingEmail = email
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+func (u *User) cancelEmailChange() {
+	if u.PendingEmail != "" {
+		u.PendingEmail = ""
+		u.UpdatedAt = time.Now()
+	}
+}
+
+// confirmEmailChange moves u to the pending address, which the opened link
+// has just verified.
+func (u *User) confirmEmailChange(at time.Time) {
+	u.Email = u.PendingEmail
+	u.PendingEmail = ""
+	u.markEmailVerified(at)
+}
+
+func (u *User) markEmailUnverified() {
+	u.EmailVerifiedAt = nil
+	u.UpdatedAt = time.Now()
+}
+
 func (u *User) addRefreshToken(newToken *RefreshToken) error {
 	u.refreshTokens[newToken.ID] = newToken
+	u.newTokens = append(u.newTokens, newToken)
 	return nil
 }
 
 func (u *User) revokeRefreshToken(tokenID uuid.UUID) error {
 	token, exists := u.refreshTokens[tokenID]
 	if !exists {
+		if revoked, ok := u.revokedTokens[tokenID]; ok && revoked.IsRevoked() {
+			return ErrRefreshTokenReused
+		}
 		return ErrInvalidRefreshToken
 	}
 
+	token.revoke(time.Now())
 	u.revokedTokens[tokenID] = token
+	u.newlyRevoked = append(u.newlyRevoked, token)
 	delete(u.refreshTokens, tokenID)
 	return nil
 }
 
+// revokeTokenFamily revokes every still active token issued from the same
+// login as the given one.
+func (u *User) revokeTokenFamily(familyID uuid.UUID) {
+	for id, token := range u.refreshTokens {
+		if token.FamilyID == familyID {
+			_ = u.revokeRefreshToken(id)
+		}
+	}
+	u.endSession(familyID)
+}
+
+func (u *User) revokeSession(familyID uuid.UUID) error {
+	for _, token := range u.refreshTokens {
+		if token.FamilyID == familyID {
+			u.revokeTokenFamily(familyID)
+			return nil
+		}
+	}
+
+	return ErrSessionNotFound
+}
+
+func (u *User) revokeAllSessions() {
+	for id, token := range u.refreshTokens {
+		_ = u.revokeRefreshToken(id)
+		u.endSession(token.FamilyID)
+	}
+}
+
+// revokeOtherSessions signs u out everywhere except the given session.
+func (u *User) revokeOtherSessions(keep uuid.UUID) {
+	for id, token := range u.refreshTokens {
+		if token.FamilyID != keep {
+			_ = u.revokeRefreshToken(id)
+			u.endSession(token.FamilyID)
+		}
+	}
+}
+
+func (u *User) endSession(familyID uuid.UUID) {
+	if !slices.Contains(u.endedSessions, familyID) {
+		u.endedSessions = append(u.endedSessions, familyID)
+	}
+}
+
+// EndedSessions lists the sessions revoked since u was loaded, so the access
+// tokens issued for them can be revoked too.
+func (u *User) EndedSessions() []uuid.UUID {
+	return u.endedSessions
+}
+
+// MarkStored records that the repository stored u, so that saving u again
+// only writes the changes made after this.
+func (u *User) MarkStored() {
+	u.newTokens = nil
+	u.newlyRevoked = nil
+	u.storedMFA = u.MFA
+	u.storedMFA.RecoveryCodes = slices.Clone(u.MFA.RecoveryCodes)
+	u.mfaChanged = false
+	u.roleChanges = nil
+	u.storedAdmin = u.isActiveAdmin()
+}
+
+// RoleChanges lists the role assignments made since u was loaded, for the
+// repository to record along with u.
+func (u *User) RoleChanges() []*RoleChange {
+	return u.roleChanges
+}
+
+// LeavesAdmins reports whether u was an active admin when loaded and no
+// longer is, by a role change or a deletion. The repository then refuses to
+// store u if no other admin is left.
+func (u *User) LeavesAdmins() bool {
+	return u.storedAdmin && !u.isActiveAdmin()
+}
+
+// NewRefreshTokens lists the tokens issued since u was loaded, for the
+// repository to insert.
+func (u *User) NewRefreshTokens() []*RefreshToken {
+	return u.newTokens
+}
+
+// NewlyRevokedTokens lists the tokens revoked since u was loaded. The
+// repository must store each of these revocations exactly once, so that two
+// concurrent rotations of the same token cannot both succeed.
+func (u *User) NewlyRevokedTokens() []*RefreshToken {
+	return u.newlyRevoked
+}
+
+func (u *User) findRefreshToken(tokenID uuid.UUID) *RefreshToken {
+	if token, ok := u.refreshTokens[tokenID]; ok {
+		return token
+	}
+	return u.revokedTokens[tokenID]
+}
+
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
 }
 
+func (u *User) isActiveAdmin() bool {
+	return u.IsAdmin() && !u.IsDeleted()
+}
+
 func (u *User) IsMentor() bool {
 	return u.Role == RoleMentor
 }
@@ -160,6 +356,24 @@ func validateEmail(email string) error {
 	return nil
 }
 
+func (r Role) IsValid() bool {
+	return isValidRole(r)
+}
+
+// rank orders roles by privilege.
+func (r Role) rank() int {
+	switch r {
+	case RoleAdmin:
+		return 3
+	case RoleMentor:
+		return 2
+	case RoleStudent:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func isValidRole(role Role) bool {
 	switch role {
 	case RoleStudent, RoleMentor, RoleAdmin:
@@ -169,22 +383,31 @@ func isValidRole(role Role) bool {
 	}
 }
 
-func NewUserFromStorage(id uuid.UUID, email, firstName, lastName, password string, role Role, createdAt, updatedAt time.Time, tokens []*RefreshToken) *User {
+func NewUserFromStorage(id uuid.UUID, email, firstName, lastName, password string, role Role, emailVerifiedAt *time.Time, mfa MFASettings, profile Profile, createdAt, updatedAt time.Time, deletedAt *time.Time, version int, tokens []*RefreshToken) *User {
 	user := &User{
-		ID:            id,
-		FirstName:     firstName,
-		LastName:      lastName,
-		Email:         email,
-		Password:      password,
-		Role:          role,
-		CreatedAt:     createdAt,
-		UpdatedAt:     updatedAt,
-		refreshTokens: make(map[uuid.UUID]*RefreshToken),
-		revokedTokens: make(map[uuid.UUID]*RefreshToken),
+		ID:              id,
+		FirstName:       firstName,
+		LastName:        lastName,
+		Email:           email,
+		Password:        password,
+		Role:            role,
+		EmailVerifiedAt: emailVerifiedAt,
+		MFA:             mfa,
+		Profile:         profile,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+		DeletedAt:       deletedAt,
+		Version:         version,
+		refreshTokens:   make(map[uuid.UUID]*RefreshToken),
+		revokedTokens:   make(map[uuid.UUID]*RefreshToken),
 	}
 
+	user.storedMFA = mfa
+	user.storedMFA.RecoveryCodes = slices.Clone(mfa.RecoveryCodes)
+	user.storedAdmin = user.isActiveAdmin()
+
 	for _, token := range tokens {
-		if token.IsExpired() {
+		if token.IsExpired() || token.IsRevoked() {
 			user.revokedTokens[token.ID] = token
 		} else {
 			user.refreshTokens[token.ID] = token
@@ -194,19 +417,26 @@ func NewUserFromStorage(id uuid.UUID, email, firstName, lastName, password strin
 	return user
 }
 
-func NewRefreshTokenFromStorage(id uuid.UUID, expiresAt, createdAt time.Time) *RefreshToken {
+func NewRefreshTokenFromStorage(id, familyID uuid.UUID, userAgent, ip string, expiresAt, createdAt time.Time, revokedAt *time.Time) *RefreshToken {
 	return &RefreshToken{
 		ID:        id,
+		FamilyID:  familyID,
+		UserAgent: userAgent,
+		IP:        ip,
 		ExpiresAt: expiresAt,
 		CreatedAt: createdAt,
+		RevokedAt: revokedAt,
 	}
 }
 
-func newRefreshToken(duration time.Duration) (*RefreshToken, error) {
+func newRefreshToken(duration time.Duration, familyID uuid.UUID, client ClientInfo) (*RefreshToken, error) {
 	now := time.Now()
 
 	return &RefreshToken{
 		ID:        uuid.New(),
+		FamilyID:  familyID,
+		UserAgent: client.UserAgent,
+		IP:        client.IP,
 		ExpiresAt: now.Add(duration),
 		CreatedAt: now,
 	}, nil
@@ -0,0 +1,177 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newTestService() *Service {
+	return NewService(nil, nil, Policy{RefreshTokenTTL: time.Hour})
+}
+
+func newTestUser(t *testing.T, s *Service) (*User, *RefreshToken) {
+	t.Helper()
+	u := NewUserFromStorage(uuid.New(), "ann@example.com", "Ann", "Lee", "", RoleStudent,
+		nil, MFASettings{}, Profile{}, time.Now(), time.Now(), nil, 1, nil)
+
+	token, err := s.CreateRefreshToken(context.Background(), u, ClientInfo{})
+	if err != nil {
+		t.Fatalf("CreateRefreshToken: %v", err)
+	}
+	return u, token
+}
+
+func TestRenewRefreshTokenRotates(t *testing.T) {
+	s := newTestService()
+	u, first := newTestUser(t, s)
+
+	second, err := s.RenewRefreshToken(context.Background(), u, first.ID, ClientInfo{})
+	if err != nil {
+		t.Fatalf("RenewRefreshToken: %v", err)
+	}
+
+	if second.ID == first.ID {
+		t.Error("the renewed token kept the old id")
+	}
+	if second.FamilyID != first.FamilyID {
+		t.Error("the renewed token left the session")
+	}
+	if !first.IsRevoked() {
+		t.Error("the rotated token is still active")
+	}
+	if got := u.NewlyRevokedTokens(); len(got) != 1 || got[0].ID != first.ID {
+		t.Errorf("newly revoked %v, want only the rotated token", got)
+	}
+	if got := u.GetRefreshTokens(); len(got) != 1 || got[0].ID != second.ID {
+		t.Errorf("active tokens %v, want only the renewed one", got)
+	}
+	if len(u.EndedSessions()) != 0 {
+		t.Errorf("rotation ended sessions %v", u.EndedSessions())
+	}
+}
+
+func TestRenewRefreshTokenDetectsReuse(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	u, first := newTestUser(t, s)
+
+	other, err := s.CreateRefreshToken(ctx, u, ClientInfo{})
+	if err != nil {
+		t.Fatalf("CreateRefreshToken: %v", err)
+	}
+
+	second, err := s.RenewRefreshToken(ctx, u, first.ID, ClientInfo{})
+	if err != nil {
+		t.Fatalf("RenewRefreshToken: %v", err)
+	}
+
+	_, err = s.RenewRefreshToken(ctx, u, first.ID, ClientInfo{})
+	if !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("reusing the rotated token: got %v, want %v", err, ErrRefreshTokenReused)
+	}
+
+	if !second.IsRevoked() {
+		t.Error("reuse left the current token of the session active")
+	}
+	if other.IsRevoked() {
+		t.Error("reuse revoked another session")
+	}
+	if got := u.EndedSessions(); !slices.Equal(got, []uuid.UUID{first.FamilyID}) {
+		t.Errorf("ended sessions %v, want %v", got, first.FamilyID)
+	}
+
+	// Every revocation is recorded once, so the repository can reject a
+	// token it finds already revoked.
+	var revoked []uuid.UUID
+	for _, token := range u.NewlyRevokedTokens() {
+		revoked = append(revoked, token.ID)
+	}
+	if !slices.Equal(revoked, []uuid.UUID{first.ID, second.ID}) {
+		t.Errorf("newly revoked %v, want %v", revoked, []uuid.UUID{first.ID, second.ID})
+	}
+}
+
+func TestRenewRefreshTokenRejects(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		token func(u *User, current *RefreshToken) uuid.UUID
+	}{
+		{"unknown token", func(*User, *RefreshToken) uuid.UUID { return uuid.New() }},
+		{"expired token", func(u *User, current *RefreshToken) uuid.UUID {
+			expired := NewRefreshTokenFromStorage(uuid.New(), current.FamilyID, "", "",
+				time.Now().Add(-time.Minute), time.Now().Add(-time.Hour), nil)
+			u.revokedTokens[expired.ID] = expired
+			return expired.ID
+		}},
+	}
+
+	for _, tt := range tests {
+		u, current := newTestUser(t, s)
+
+		_, err := s.RenewRefreshToken(ctx, u, tt.token(u, current), ClientInfo{})
+		if !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Errorf("%s: got %v, want %v", tt.name, err, ErrInvalidRefreshToken)
+		}
+		if current.IsRevoked() || len(u.NewlyRevokedTokens()) != 0 {
+			t.Errorf("%s: revoked a token", tt.name)
+		}
+	}
+}
+
+func TestVerifyMFACodeTracksChange(t *testing.T) {
+	codes, hashes := generateRecoveryCodes()
+	enabledAt := time.Now()
+	u := NewUserFromStorage(uuid.New(), "ann@example.com", "Ann", "Lee", "", RoleStudent,
+		nil, MFASettings{Secret: "JBSWY3DPEHPK3PXP", EnabledAt: &enabledAt, RecoveryCodes: hashes},
+		Profile{}, time.Now(), time.Now(), nil, 1, nil)
+
+	if err := u.verifyMFACode("000000-wrong", time.Now()); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("wrong code: got %v", err)
+	}
+	if u.MFAChanged() {
+		t.Error("a wrong code changed the MFA settings")
+	}
+
+	if err := u.verifyMFACode(codes[3], time.Now()); err != nil {
+		t.Fatalf("recovery code: %v", err)
+	}
+	if !u.MFAChanged() {
+		t.Error("spending a recovery code left the MFA settings unchanged")
+	}
+	if got := len(u.MFA.RecoveryCodes); got != len(hashes)-1 {
+		t.Errorf("%d recovery codes left, want %d", got, len(hashes)-1)
+	}
+	if !slices.Equal(u.StoredMFA().RecoveryCodes, hashes) {
+		t.Error("the stored settings lost the spent code")
+	}
+}
+
+func TestMarkStoredForgetsSavedChanges(t *testing.T) {
+	s := newTestService()
+	u, first := newTestUser(t, s)
+
+	second, err := s.RenewRefreshToken(context.Background(), u, first.ID, ClientInfo{})
+	if err != nil {
+		t.Fatalf("RenewRefreshToken: %v", err)
+	}
+	if got := u.NewRefreshTokens(); len(got) != 2 || got[1].ID != second.ID {
+		t.Fatalf("new tokens %v, want the first and the renewed one", got)
+	}
+
+	u.MarkStored()
+
+	if len(u.NewRefreshTokens()) != 0 || len(u.NewlyRevokedTokens()) != 0 {
+		t.Error("stored tokens would be written again")
+	}
+	if got := u.GetRefreshTokens(); len(got) != 1 || got[0].ID != second.ID {
+		t.Errorf("active tokens %v, want only the renewed one", got)
+	}
+}
@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"trainer/internal/application"
+	"trainer/internal/domain/audit"
+)
+
+// Auditor stores events synchronously, so they are committed by the time the
+// request returns, and logs the ones it could not store.
+type Auditor struct {
+	repo audit.Repository
+}
+
+func NewAuditor(repo audit.Repository) application.Auditor {
+	return &Auditor{repo: repo}
+}
+
+func (a *Auditor) Record(ctx context.Context, event *audit.Event) {
+	// The action has already happened; record it even if the client left.
+	ctx = context.WithoutCancel(ctx)
+
+	if err := a.repo.Save(ctx, event); err != nil {
+		log.Printf("audit %s actor=%s target=%s: %v", event.Action, event.ActorID, event.TargetID, err)
+	}
+}
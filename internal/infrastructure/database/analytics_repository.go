@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/review"
+
+	"github.com/google/uuid"
+)
+
+type AnalyticsRepository struct {
+	db *DB
+}
+
+func NewAnalyticsRepository(db *DB) analytics.Repository {
+	return &AnalyticsRepository{
+		db: db,
+	}
+}
+
+// reviewDay is the calendar day of a review in the time zone given as $1;
+// review times are stored in UTC.
+const reviewDay = `(l.reviewed_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date`
+
+// reviewTotals aggregates review_logs l into the columns of
+// analytics.Totals; $2 is the grade of a failed review.
+const reviewTotals = `
+	COUNT(l.id) AS reviews,
+	COUNT(l.id) FILTER (WHERE l.interval_days > 0) AS recall_reviews,
+	COUNT(l.id) FILTER (WHERE l.interval_days > 0 AND l.grade > $2) AS recalled,
+	COALESCE(SUM(l.duration_ms), 0) AS duration_ms`
+
+func (r *AnalyticsRepository) Daily(ctx context.Context, studentIDs []uuid.UUID, rg analytics.Range) ([]*analytics.Day, error) {
+	query := `
+		SELECT ` + reviewDay + ` AS day,` + reviewTotals + `,
+			COUNT(DISTINCT l.student_id)
+		FROM review_logs l
+		WHERE l.student_id = ANY($3) AND l.reviewed_at >= $4 AND l.reviewed_at < $5
+		GROUP BY day
+		ORDER BY day
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, rg.Location.String(), int(review.GradeAgain), studentIDs, rg.From.UTC(), rg.To.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := make([]*analytics.Day, 0)
+	for rows.Next() {
+		var (
+			d          analytics.Day
+			durationMs int64
+		)
+		err := rows.Scan(&d.Date, &d.Reviews, &d.RecallReviews, &d.Recalled, &durationMs, &d.ActiveStudents)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		d.TimeSpent = time.Duration(durationMs) * time.Millisecond
+		days = append(days, &d)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return days, nil
+}
+
+func (r *AnalyticsRepository) Students(ctx context.Context, studentIDs []uuid.UUID, rg analytics.Range, today time.Time) ([]*analytics.StudentSummary, error) {
+	// Consecutive days share day - row_number, which groups them into runs.
+	query := `
+		WITH totals AS (
+			SELECT st.student_id, st.n,` + reviewTotals + `,
+				COUNT(DISTINCT ` + reviewDay + `) AS active_days,
+				MAX(l.reviewed_at) AS last_reviewed_at
+			FROM unnest($3::uuid[]) WITH ORDINALITY AS st (student_id, n)
+			LEFT JOIN review_logs l ON l.student_id = st.student_id AND l.reviewed_at >= $4 AND l.reviewed_at < $5
+			GROUP BY st.student_id, st.n
+		), days AS (
+			SELECT DISTINCT l.student_id, ` + reviewDay + ` AS day
+			FROM review_logs l
+			WHERE l.student_id = ANY($3)
+		), runs AS (
+			SELECT student_id, MAX(day) AS last_day, COUNT(*) AS length
+			FROM (
+				SELECT student_id, day, day - (ROW_NUMBER() OVER (PARTITION BY student_id ORDER BY day))::int AS run
+				FROM days
+			) d
+			GROUP BY student_id, run
+		), streaks AS (
+			SELECT student_id,
+				COALESCE(MAX(length) FILTER (WHERE last_day >= $6::date - 1), 0) AS current_streak,
+				MAX(length) AS longest_streak
+			FROM runs
+			GROUP BY student_id
+		)
+		SELECT t.student_id, t.reviews, t.recall_reviews, t.recalled, t.duration_ms, t.active_days,
+			COALESCE(s.current_streak, 0), COALESCE(s.longest_streak, 0), t.last_reviewed_at
+		FROM totals t
+		LEFT JOIN streaks s ON s.student_id = t.student_id
+		ORDER BY t.n
+	`
+
+	rows, err := r.db.pool.Query(ctx, query,
+		rg.Location.String(), int(review.GradeAgain), studentIDs, rg.From.UTC(), rg.To.UTC(),
+		time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := make([]*analytics.StudentSummary, 0, len(studentIDs))
+	for rows.Next() {
+		var (
+			s          analytics.StudentSummary
+			durationMs int64
+		)
+		err := rows.Scan(
+			&s.StudentID, &s.Reviews, &s.RecallReviews, &s.Recalled, &durationMs, &s.ActiveDays,
+			&s.CurrentStreak, &s.LongestStreak, &s.LastReviewedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		s.TimeSpent = time.Duration(durationMs) * time.Millisecond
+		summaries = append(summaries, &s)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return summaries, nil
+}
+
+func (r *AnalyticsRepository) HardestTerms(ctx context.Context, studentIDs []uuid.UUID, ownerID uuid.UUID, rg analytics.Range, limit int) ([]*analytics.TermStats, error) {
+	args := []any{int(review.GradeAgain), studentIDs, rg.From.UTC(), rg.To.UTC(), limit}
+	ownerFilter := ""
+	if ownerID != uuid.Nil {
+		args = append(args, ownerID)
+		ownerFilter = "AND d.owner_id = $6"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t.id, t.deck_id, t.front, s.reviews, s.failures
+		FROM (
+			SELECT l.term_id, COUNT(*) AS reviews, COUNT(*) FILTER (WHERE l.grade = $1) AS failures
+			FROM review_logs l
+			WHERE l.student_id = ANY($2) AND l.reviewed_at >= $3 AND l.reviewed_at < $4
+			GROUP BY l.term_id
+		) s
+		JOIN terms t ON t.id = s.term_id
+		JOIN decks d ON d.id = t.deck_id
+		WHERE s.failures > 0 %s
+		ORDER BY s.failures::float / s.reviews DESC, s.failures DESC, t.id
+		LIMIT $5
+	`, ownerFilter)
+
+	rows, err := r.db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := make([]*analytics.TermStats, 0)
+	for rows.Next() {
+		var t analytics.TermStats
+		if err := rows.Scan(&t.TermID, &t.DeckID, &t.Front, &t.Reviews, &t.Failures); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		terms = append(terms, &t)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return terms, nil
+}
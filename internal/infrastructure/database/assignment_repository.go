@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"trainer/internal/domain/assignment"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type AssignmentRepository struct {
+	db *DB
+}
+
+func NewAssignmentRepository(db *DB) assignment.Repository {
+	return &AssignmentRepository{
+		db: db,
+	}
+}
+
+const assignmentColumns = `a.id, a.cohort_id, a.deck_id, a.title, a.due_at, a.target_percent, a.created_at, a.updated_at`
+
+func (r *AssignmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*assignment.Assignment, error) {
+	query := `SELECT ` + assignmentColumns + ` FROM assignments a WHERE a.id = $1`
+
+	a, err := scanAssignment(r.db.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (r *AssignmentRepository) List(ctx context.Context, q assignment.ListQuery) ([]*assignment.Assignment, error) {
+	var where sqlWhere
+	if q.CohortID != uuid.Nil {
+		where.add("a.cohort_id = " + where.arg(q.CohortID))
+	}
+	if q.StudentID != uuid.Nil {
+		where.add("EXISTS (SELECT 1 FROM cohort_members m WHERE m.cohort_id = a.cohort_id AND m.student_id = " + where.arg(q.StudentID) + ")")
+	}
+
+	query := `SELECT ` + assignmentColumns + ` FROM assignments a` + where.String() + ` ORDER BY a.due_at, a.id`
+
+	rows, err := r.db.pool.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]*assignment.Assignment, 0)
+	for rows.Next() {
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return assignments, nil
+}
+
+func (r *AssignmentRepository) Save(ctx context.Context, a *assignment.Assignment) error {
+	query := `
+		INSERT INTO assignments (id, cohort_id, deck_id, title, due_at, target_percent, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	return r.db.Exec(ctx, query, a.ID, a.CohortID, a.DeckID, a.Title, a.DueAt, a.TargetPercent, a.CreatedAt, a.UpdatedAt)
+}
+
+func (r *AssignmentRepository) Update(ctx context.Context, a *assignment.Assignment) error {
+	query := `
+		UPDATE assignments
+		SET title = $2, due_at = $3, target_percent = $4, updated_at = $5
+		WHERE id = $1
+	`
+	return r.db.Exec(ctx, query, a.ID, a.Title, a.DueAt, a.TargetPercent, a.UpdatedAt)
+}
+
+func (r *AssignmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.Exec(ctx, `DELETE FROM assignments WHERE id = $1`, id)
+}
+
+func (r *AssignmentRepository) AssignedDecks(ctx context.Context, studentID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT DISTINCT a.deck_id
+		FROM assignments a
+		JOIN cohort_members m ON m.cohort_id = a.cohort_id
+		WHERE m.student_id = $1
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+func (r *AssignmentRepository) Progress(ctx context.Context, deckID uuid.UUID, studentIDs []uuid.UUID) ([]*assignment.Progress, error) {
+	var total int
+	if err := r.db.pool.QueryRow(ctx, `SELECT COUNT(*) FROM terms WHERE deck_id = $1 AND deleted_at IS NULL`, deckID).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT st.student_id,
+			COUNT(s.term_id),
+			COUNT(s.term_id) FILTER (WHERE s.interval_days >= $3),
+			MAX(s.last_reviewed_at)
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS st (student_id, n)
+		LEFT JOIN review_states s ON s.student_id = st.student_id AND s.deck_id = $1
+			AND EXISTS (SELECT 1 FROM terms t WHERE t.id = s.term_id AND t.deleted_at IS NULL)
+		GROUP BY st.student_id, st.n
+		ORDER BY st.n
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, deckID, studentIDs, assignment.MasteryIntervalDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := make([]*assignment.Progress, 0, len(studentIDs))
+	for rows.Next() {
+		p := assignment.Progress{TotalTerms: total}
+		if err := rows.Scan(&p.StudentID, &p.ReviewedTerms, &p.MasteredTerms, &p.LastReviewedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		progress = append(progress, &p)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return progress, nil
+}
+
+func (r *AssignmentRepository) StudentProgress(ctx context.Context, studentID uuid.UUID, deckIDs []uuid.UUID) ([]*assignment.Progress, error) {
+	query := `
+		SELECT (SELECT COUNT(*) FROM terms t WHERE t.deck_id = dk.deck_id AND t.deleted_at IS NULL),
+			COUNT(s.term_id),
+			COUNT(s.term_id) FILTER (WHERE s.interval_days >= $3),
+			MAX(s.last_reviewed_at)
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS dk (deck_id, n)
+		LEFT JOIN review_states s ON s.student_id = $1 AND s.deck_id = dk.deck_id
+			AND EXISTS (SELECT 1 FROM terms t WHERE t.id = s.term_id AND t.deleted_at IS NULL)
+		GROUP BY dk.deck_id, dk.n
+		ORDER BY dk.n
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, studentID, deckIDs, assignment.MasteryIntervalDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := make([]*assignment.Progress, 0, len(deckIDs))
+	for rows.Next() {
+		p := assignment.Progress{StudentID: studentID}
+		if err := rows.Scan(&p.TotalTerms, &p.ReviewedTerms, &p.MasteredTerms, &p.LastReviewedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		progress = append(progress, &p)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return progress, nil
+}
+
+func scanAssignment(row pgx.Row) (*assignment.Assignment, error) {
+	var a assignment.Assignment
+	err := row.Scan(&a.ID, &a.CohortID, &a.DeckID, &a.Title, &a.DueAt, &a.TargetPercent, &a.CreatedAt, &a.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trainer/internal/domain/audit"
+
+	uuid "github.com/google/uuid"
+)
+
+type AuditEventRepository struct {
+	db *DB
+}
+
+func NewAuditEventRepository(db *DB) audit.Repository {
+	return &AuditEventRepository{
+		db: db,
+	}
+}
+
+func (r *AuditEventRepository) Save(ctx context.Context, e *audit.Event) error {
+	query := `
+		INSERT INTO audit_events (
+			id, action, actor_id, target_id, ip, user_agent, before_state, after_state, metadata, occurred_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.pool.Exec(ctx, query,
+		e.ID, e.Action, nullUUID(e.ActorID), nullUUID(e.TargetID), e.IP, e.UserAgent,
+		nullMap(e.Before), nullMap(e.After), nullMap(e.Metadata), e.OccurredAt,
+	)
+	return err
+}
+
+func (r *AuditEventRepository) Redact(ctx context.Context, targetIDs []uuid.UUID, fields []string) error {
+	query := `
+		UPDATE audit_events
+		SET before_state = before_state - $2::text[], after_state = after_state - $2::text[]
+		WHERE target_id = ANY($1)
+	`
+	_, err := r.db.pool.Exec(ctx, query, targetIDs, fields)
+	return err
+}
+
+func (r *AuditEventRepository) Find(ctx context.Context, q audit.Query) (*audit.Page, error) {
+	where := &sqlWhere{}
+	if q.Action != "" {
+		where.add("action = " + where.arg(q.Action))
+	}
+	if q.ActorID != uuid.Nil {
+		where.add("actor_id = " + where.arg(q.ActorID))
+	}
+	if q.TargetID != uuid.Nil {
+		where.add("target_id = " + where.arg(q.TargetID))
+	}
+	if q.From != nil {
+		where.add("occurred_at >= " + where.arg(*q.From))
+	}
+	if q.To != nil {
+		where.add("occurred_at < " + where.arg(*q.To))
+	}
+	if q.After != nil {
+		where.add(fmt.Sprintf("(occurred_at, id) < (%s, %s)", where.arg(q.After.OccurredAt), where.arg(q.After.ID)))
+	}
+
+	// One extra row tells whether another page follows.
+	query := `
+		SELECT id, action, actor_id, target_id, ip, user_agent, before_state, after_state, metadata, occurred_at
+		FROM audit_events` + where.String() + `
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT ` + where.arg(q.Limit+1)
+
+	rows, err := r.db.pool.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*audit.Event, 0)
+	for rows.Next() {
+		var (
+			id         uuid.UUID
+			action     string
+			actorID    *uuid.UUID
+			targetID   *uuid.UUID
+			ip         string
+			userAgent  string
+			before     map[string]any
+			after      map[string]any
+			metadata   map[string]any
+			occurredAt time.Time
+		)
+
+		if err := rows.Scan(&id, &action, &actorID, &targetID, &ip, &userAgent, &before, &after, &metadata, &occurredAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		events = append(events, audit.NewEventFromStorage(
+			id, audit.Action(action), derefUUID(actorID), derefUUID(targetID), ip, userAgent,
+			before, after, metadata, occurredAt,
+		))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	page := &audit.Page{Events: events}
+	if len(events) > q.Limit {
+		page.Events = events[:q.Limit]
+		last := page.Events[q.Limit-1]
+		page.Next = &audit.Cursor{OccurredAt: last.OccurredAt, ID: last.ID}
+	}
+
+	return page, nil
+}
+
+func nullUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+func derefUUID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
+
+// nullMap stores empty maps as NULL rather than '{}'.
+func nullMap(m map[string]any) map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,223 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"trainer/internal/domain/cohort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CohortRepository struct {
+	db *DB
+}
+
+func NewCohortRepository(db *DB) cohort.Repository {
+	return &CohortRepository{
+		db: db,
+	}
+}
+
+const cohortColumns = `c.id, c.mentor_id, c.name, c.description, c.join_code, c.created_at, c.updated_at`
+
+func (r *CohortRepository) FindByID(ctx context.Context, id uuid.UUID) (*cohort.Cohort, error) {
+	return r.findOne(ctx, `SELECT `+cohortColumns+` FROM cohorts c WHERE c.id = $1`, id)
+}
+
+func (r *CohortRepository) FindByJoinCode(ctx context.Context, code string) (*cohort.Cohort, error) {
+	return r.findOne(ctx, `SELECT `+cohortColumns+` FROM cohorts c WHERE c.join_code = $1`, code)
+}
+
+func (r *CohortRepository) findOne(ctx context.Context, query string, arg any) (*cohort.Cohort, error) {
+	c, err := scanCohort(r.db.pool.QueryRow(ctx, query, arg))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *CohortRepository) List(ctx context.Context, q cohort.ListQuery) ([]*cohort.Summary, error) {
+	var where sqlWhere
+	if q.MentorID != uuid.Nil {
+		where.add("c.mentor_id = " + where.arg(q.MentorID))
+	}
+	if q.StudentID != uuid.Nil {
+		where.add("EXISTS (SELECT 1 FROM cohort_members m WHERE m.cohort_id = c.id AND m.student_id = " + where.arg(q.StudentID) + ")")
+	}
+
+	query := `
+		SELECT ` + cohortColumns + `,
+			(SELECT COUNT(*) FROM cohort_members m WHERE m.cohort_id = c.id)
+		FROM cohorts c` + where.String() + `
+		ORDER BY c.name, c.id
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := make([]*cohort.Summary, 0)
+	for rows.Next() {
+		var s cohort.Summary
+		if s.Cohort, err = scanCohort(rows, &s.MemberCount); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		summaries = append(summaries, &s)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return summaries, nil
+}
+
+func (r *CohortRepository) Save(ctx context.Context, c *cohort.Cohort) error {
+	query := `
+		INSERT INTO cohorts (id, mentor_id, name, description, join_code, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.pool.Exec(ctx, query, c.ID, c.MentorID, c.Name, c.Description, c.JoinCode, c.CreatedAt, c.UpdatedAt)
+	return cohortWriteError(err)
+}
+
+func (r *CohortRepository) Update(ctx context.Context, c *cohort.Cohort) error {
+	query := `
+		UPDATE cohorts
+		SET name = $2, description = $3, join_code = $4, updated_at = $5
+		WHERE id = $1
+	`
+	_, err := r.db.pool.Exec(ctx, query, c.ID, c.Name, c.Description, c.JoinCode, c.UpdatedAt)
+	return cohortWriteError(err)
+}
+
+func (r *CohortRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.Exec(ctx, `DELETE FROM cohorts WHERE id = $1`, id)
+}
+
+func (r *CohortRepository) Members(ctx context.Context, cohortID uuid.UUID) ([]*cohort.Member, error) {
+	query := `
+		SELECT cohort_id, student_id, joined_at
+		FROM cohort_members
+		WHERE cohort_id = $1
+		ORDER BY joined_at, student_id
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]*cohort.Member, 0)
+	for rows.Next() {
+		var m cohort.Member
+		if err := rows.Scan(&m.CohortID, &m.StudentID, &m.JoinedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		members = append(members, &m)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return members, nil
+}
+
+func (r *CohortRepository) AddMember(ctx context.Context, m *cohort.Member) error {
+	query := `INSERT INTO cohort_members (cohort_id, student_id, joined_at) VALUES ($1, $2, $3)`
+
+	_, err := r.db.pool.Exec(ctx, query, m.CohortID, m.StudentID, m.JoinedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return cohort.ErrAlreadyMember
+	}
+	return err
+}
+
+func (r *CohortRepository) RemoveMember(ctx context.Context, cohortID, studentID uuid.UUID) error {
+	tag, err := r.db.pool.Exec(ctx, `DELETE FROM cohort_members WHERE cohort_id = $1 AND student_id = $2`, cohortID, studentID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return cohort.ErrNotMember
+	}
+	return nil
+}
+
+func (r *CohortRepository) StudentIDs(ctx context.Context, mentorID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT DISTINCT m.student_id
+		FROM cohort_members m
+		JOIN cohorts c ON c.id = m.cohort_id
+		WHERE c.mentor_id = $1
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+func (r *CohortRepository) HasStudent(ctx context.Context, mentorID, studentID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM cohort_members m
+			JOIN cohorts c ON c.id = m.cohort_id
+			WHERE c.mentor_id = $1 AND m.student_id = $2
+		)
+	`
+
+	var found bool
+	if err := r.db.pool.QueryRow(ctx, query, mentorID, studentID).Scan(&found); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// cohortWriteError reports a join code collision; the only other unique
+// column is the primary key.
+func cohortWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return cohort.ErrJoinCodeTaken
+	}
+	return err
+}
+
+// scanCohort reads cohortColumns followed by any extra columns.
+func scanCohort(row pgx.Row, extra ...any) (*cohort.Cohort, error) {
+	var c cohort.Cohort
+	dest := append([]any{&c.ID, &c.MentorID, &c.Name, &c.Description, &c.JoinCode, &c.CreatedAt, &c.UpdatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"trainer/internal/domain/deck"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type DeckRepository struct {
+	db *DB
+}
+
+func NewDeckRepository(db *DB) deck.Repository {
+	return &DeckRepository{
+		db: db,
+	}
+}
+
+// mediaRow is the JSONB form of a deck.MediaRef.
+type mediaRow struct {
+	Kind string `json:"kind"`
+	URL  string `json:"url"`
+}
+
+func (r *DeckRepository) FindByID(ctx context.Context, id uuid.UUID) (*deck.Deck, error) {
+	query := `
+		SELECT id, owner_id, title, description, created_at, updated_at
+		FROM decks
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	var (
+		deckID      uuid.UUID
+		ownerID     *uuid.UUID
+		title       string
+		description string
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, id).Scan(&deckID, &ownerID, &title, &description, &createdAt, &updatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := r.findTerms(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	return deck.NewDeckFromStorage(deckID, derefUUID(ownerID), title, description, terms, createdAt, updatedAt), nil
+}
+
+func (r *DeckRepository) List(ctx context.Context, q deck.ListQuery) (*deck.Page, error) {
+	var where sqlWhere
+	where.add("d.deleted_at IS NULL")
+	if q.OwnerID != uuid.Nil {
+		where.add("d.owner_id = " + where.arg(q.OwnerID))
+	}
+	if q.Search != "" {
+		where.add(`d.title ILIKE ` + where.arg("%"+escapeLike(q.Search)+"%"))
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM decks d` + where.String()
+	if err := r.db.pool.QueryRow(ctx, countQuery, where.args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT d.id, d.owner_id, d.title, d.description, d.created_at, d.updated_at,
+			(SELECT COUNT(*) FROM terms t WHERE t.deck_id = d.id AND t.deleted_at IS NULL)
+		FROM decks d` + where.String() + `
+		ORDER BY d.updated_at DESC, d.id
+		LIMIT ` + where.arg(q.Limit) + ` OFFSET ` + where.arg(q.Offset)
+
+	rows, err := r.db.pool.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decks := make([]*deck.Summary, 0)
+	for rows.Next() {
+		var (
+			s       deck.Summary
+			ownerID *uuid.UUID
+		)
+		if err := rows.Scan(&s.ID, &ownerID, &s.Title, &s.Description, &s.CreatedAt, &s.UpdatedAt, &s.TermCount); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		s.OwnerID = derefUUID(ownerID)
+		decks = append(decks, &s)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return &deck.Page{Decks: decks, Total: total}, nil
+}
+
+func (r *DeckRepository) Save(ctx context.Context, d *deck.Deck) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		query := `
+			INSERT INTO decks (id, owner_id, title, description, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+		_, err := tx.Exec(ctx, query, d.ID, d.OwnerID, d.Title, d.Description, d.CreatedAt, d.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		return r.saveTerms(ctx, tx, d)
+	})
+}
+
+func (r *DeckRepository) Update(ctx context.Context, d *deck.Deck) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		query := `
+			UPDATE decks
+			SET title = $2, description = $3, updated_at = $4
+			WHERE id = $1 AND deleted_at IS NULL
+		`
+		tag, err := tx.Exec(ctx, query, d.ID, d.Title, d.Description, d.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return deck.ErrDeckNotFound
+		}
+
+		if removed := d.RemovedTerms(); len(removed) > 0 {
+			query := `
+				UPDATE terms
+				SET deleted_at = $3
+				WHERE deck_id = $1 AND id = ANY($2) AND deleted_at IS NULL
+			`
+			if _, err := tx.Exec(ctx, query, d.ID, removed, d.UpdatedAt); err != nil {
+				return err
+			}
+		}
+
+		return r.saveTerms(ctx, tx, d)
+	})
+}
+
+func (r *DeckRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		now := time.Now()
+
+		tag, err := tx.Exec(ctx, `UPDATE decks SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`, id, now)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return deck.ErrDeckNotFound
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE terms SET deleted_at = $2 WHERE deck_id = $1 AND deleted_at IS NULL`, id, now)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `DELETE FROM assignments WHERE deck_id = $1`, id)
+		return err
+	})
+}
+
+// saveTerms upserts the terms of d with their positions.
+func (r *DeckRepository) saveTerms(ctx context.Context, tx pgx.Tx, d *deck.Deck) error {
+	query := `
+		INSERT INTO terms (id, deck_id, position, front, back, examples, tags, media, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE
+		SET position = EXCLUDED.position, front = EXCLUDED.front, back = EXCLUDED.back,
+			examples = EXCLUDED.examples, tags = EXCLUDED.tags, media = EXCLUDED.media,
+			updated_at = EXCLUDED.updated_at
+		WHERE terms.deck_id = EXCLUDED.deck_id
+	`
+
+	batch := &pgx.Batch{}
+	for i, term := range d.Terms {
+		media := make([]mediaRow, len(term.Media))
+		for j, ref := range term.Media {
+			media[j] = mediaRow{Kind: string(ref.Kind), URL: ref.URL}
+		}
+		mediaJSON, err := json.Marshal(media)
+		if err != nil {
+			return err
+		}
+
+		batch.Queue(query,
+			term.ID, d.ID, i, term.Front, term.Back, term.Examples, term.Tags, mediaJSON, term.CreatedAt, term.UpdatedAt,
+		)
+	}
+
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+func (r *DeckRepository) FindTerms(ctx context.Context, ids []uuid.UUID) ([]*deck.Term, error) {
+	query := `
+		SELECT ` + termColumns + `
+		FROM terms
+		WHERE id = ANY($1) AND deleted_at IS NULL
+	`
+
+	return r.queryTerms(ctx, query, ids)
+}
+
+func (r *DeckRepository) findTerms(ctx context.Context, deckID uuid.UUID) ([]*deck.Term, error) {
+	query := `
+		SELECT ` + termColumns + `
+		FROM terms
+		WHERE deck_id = $1 AND deleted_at IS NULL
+		ORDER BY position
+	`
+
+	return r.queryTerms(ctx, query, deckID)
+}
+
+const termColumns = `id, deck_id, front, back, examples, tags, media, created_at, updated_at`
+
+func (r *DeckRepository) queryTerms(ctx context.Context, query string, args ...any) ([]*deck.Term, error) {
+	rows, err := r.db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := make([]*deck.Term, 0)
+	for rows.Next() {
+		var (
+			id        uuid.UUID
+			deckID    uuid.UUID
+			front     string
+			back      string
+			examples  []string
+			tags      []string
+			mediaJSON []byte
+			createdAt time.Time
+			updatedAt time.Time
+		)
+
+		if err := rows.Scan(&id, &deckID, &front, &back, &examples, &tags, &mediaJSON, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		var rowsMedia []mediaRow
+		if err := json.Unmarshal(mediaJSON, &rowsMedia); err != nil {
+			return nil, fmt.Errorf("decode media: %w", err)
+		}
+		media := make([]deck.MediaRef, len(rowsMedia))
+		for i, m := range rowsMedia {
+			media[i] = deck.MediaRef{Kind: deck.MediaKind(m.Kind), URL: m.URL}
+		}
+
+		terms = append(terms, deck.NewTermFromStorage(id, deckID, front, back, examples, tags, media, createdAt, updatedAt))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return terms, nil
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"time"
+	"trainer/internal/domain/user"
+
+	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type EmailVerificationRepository struct {
+	db *DB
+}
+
+func NewEmailVerificationRepository(db *DB) user.EmailVerificationRepository {
+	return &EmailVerificationRepository{
+		db: db,
+	}
+}
+
+func (r *EmailVerificationRepository) Save(ctx context.Context, t *user.EmailVerificationToken) error {
+	query := `
+		INSERT INTO email_verification_tokens (id, user_id, email, token_hash, expires_at, created_at, used_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	return r.db.Exec(ctx, query, t.ID, t.UserID, t.Email, t.TokenHash, t.ExpiresAt, t.CreatedAt, t.UsedAt)
+}
+
+func (r *EmailVerificationRepository) FindByHash(ctx context.Context, hash string) (*user.EmailVerificationToken, error) {
+	query := `
+		SELECT id, user_id, email, token_hash, expires_at, created_at, used_at
+		FROM email_verification_tokens
+		WHERE token_hash = $1
+	`
+
+	var (
+		id        uuid.UUID
+		userID    uuid.UUID
+		email     string
+		tokenHash string
+		expiresAt time.Time
+		createdAt time.Time
+		usedAt    *time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, hash).Scan(&id, &userID, &email, &tokenHash, &expiresAt, &createdAt, &usedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return user.NewEmailVerificationTokenFromStorage(id, userID, email, tokenHash, expiresAt, createdAt, usedAt), nil
+}
+
+func (r *EmailVerificationRepository) Consume(ctx context.Context, t *user.EmailVerificationToken) error {
+	usedAt := time.Now()
+	if t.UsedAt != nil {
+		usedAt = *t.UsedAt
+	}
+
+	query := `
+		UPDATE email_verification_tokens
+		SET used_at = $2
+		WHERE user_id = $1 AND used_at IS NULL
+	`
+
+	return r.db.Exec(ctx, query, t.UserID, usedAt)
+}
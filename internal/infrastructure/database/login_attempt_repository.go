@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"time"
+	"trainer/internal/domain/user"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type LoginAttemptRepository struct {
+	db *DB
+}
+
+func NewLoginAttemptRepository(db *DB) user.LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		db: db,
+	}
+}
+
+func (r *LoginAttemptRepository) Find(ctx context.Context, key string) (*user.LoginAttempts, error) {
+	query := `
+		SELECT key, failures, last_failure_at, locked_until
+		FROM login_attempts
+		WHERE key = $1
+	`
+
+	var (
+		attemptKey    string
+		failures      int
+		lastFailureAt time.Time
+		lockedUntil   *time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, key).Scan(&attemptKey, &failures, &lastFailureAt, &lockedUntil)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return user.NewLoginAttemptsFromStorage(attemptKey, failures, lastFailureAt, lockedUntil), nil
+}
+
+func (r *LoginAttemptRepository) Increment(ctx context.Context, key string, at, forgetBefore time.Time) (*user.LoginAttempts, error) {
+	// Mirrors LoginAttempts.IsStale; the SET expressions all see the old row.
+	query := `
+		INSERT INTO login_attempts (key, failures, last_failure_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN login_attempts.locked_until IS NOT NULL AND login_attempts.locked_until <= $2 THEN 1
+				WHEN login_attempts.locked_until IS NULL AND login_attempts.last_failure_at <= $3 THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			locked_until = CASE
+				WHEN login_attempts.locked_until <= $2 THEN NULL
+				ELSE login_attempts.locked_until
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+		RETURNING key, failures, last_failure_at, locked_until
+	`
+
+	var (
+		attemptKey    string
+		failures      int
+		lastFailureAt time.Time
+		lockedUntil   *time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, key, at, forgetBefore).Scan(&attemptKey, &failures, &lastFailureAt, &lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.NewLoginAttemptsFromStorage(attemptKey, failures, lastFailureAt, lockedUntil), nil
+}
+
+func (r *LoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	return r.db.Exec(ctx, `UPDATE login_attempts SET locked_until = $2 WHERE key = $1`, key, until)
+}
+
+func (r *LoginAttemptRepository) Delete(ctx context.Context, key string) error {
+	return r.db.Exec(ctx, `DELETE FROM login_attempts WHERE key = $1`, key)
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"time"
+	"trainer/internal/domain/user"
+
+	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type MFAChallengeRepository struct {
+	db *DB
+}
+
+func NewMFAChallengeRepository(db *DB) user.MFAChallengeRepository {
+	return &MFAChallengeRepository{
+		db: db,
+	}
+}
+
+func (r *MFAChallengeRepository) Save(ctx context.Context, c *user.MFAChallenge) error {
+	query := `
+		INSERT INTO mfa_challenges (id, user_id, token_hash, user_agent, ip, attempts, expires_at, created_at, used_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	return r.db.Exec(ctx, query, c.ID, c.UserID, c.TokenHash, c.UserAgent, c.IP, c.Attempts, c.ExpiresAt, c.CreatedAt, c.UsedAt)
+}
+
+func (r *MFAChallengeRepository) FindByHash(ctx context.Context, hash string) (*user.MFAChallenge, error) {
+	query := `
+		SELECT id, user_id, token_hash, user_agent, ip, attempts, expires_at, created_at, used_at
+		FROM mfa_challenges
+		WHERE token_hash = $1
+	`
+
+	var (
+		id        uuid.UUID
+		userID    uuid.UUID
+		tokenHash string
+		userAgent string
+		ip        string
+		attempts  int
+		expiresAt time.Time
+		createdAt time.Time
+		usedAt    *time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, hash).Scan(&id, &userID, &tokenHash, &userAgent, &ip, &attempts, &expiresAt, &createdAt, &usedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return user.NewMFAChallengeFromStorage(id, userID, tokenHash, userAgent, ip, attempts, expiresAt, createdAt, usedAt), nil
+}
+
+func (r *MFAChallengeRepository) Consume(ctx context.Context, c *user.MFAChallenge) error {
+	usedAt := time.Now()
+	if c.UsedAt != nil {
+		usedAt = *c.UsedAt
+	}
+
+	query := `
+		UPDATE mfa_challenges
+		SET used_at = $2
+		WHERE id = $1 AND used_at IS NULL
+	`
+	tag, err := r.db.pool.Exec(ctx, query, c.ID, usedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return user.ErrInvalidMFAChallenge
+	}
+
+	return nil
+}
+
+func (r *MFAChallengeRepository) AddFailure(ctx context.Context, c *user.MFAChallenge) error {
+	query := `
+		UPDATE mfa_challenges
+		SET attempts = attempts + 1
+		WHERE id = $1 AND used_at IS NULL
+	`
+
+	return r.db.Exec(ctx, query, c.ID)
+}
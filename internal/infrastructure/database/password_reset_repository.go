@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"time"
+	"trainer/internal/domain/user"
+
+	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PasswordResetRepository struct {
+	db *DB
+}
+
+func NewPasswordResetRepository(db *DB) user.PasswordResetRepository {
+	return &PasswordResetRepository{
+		db: db,
+	}
+}
+
+func (r *PasswordResetRepository) Save(ctx context.Context, t *user.PasswordResetToken) error {
+	query := `
+		INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at, used_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	return r.db.Exec(ctx, query, t.ID, t.UserID, t.TokenHash, t.ExpiresAt, t.CreatedAt, t.UsedAt)
+}
+
+func (r *PasswordResetRepository) FindByHash(ctx context.Context, hash string) (*user.PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, created_at, used_at
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+	`
+
+	var (
+		id        uuid.UUID
+		userID    uuid.UUID
+		tokenHash string
+		expiresAt time.Time
+		createdAt time.Time
+		usedAt    *time.Time
+	)
+
+	err := r.db.pool.QueryRow(ctx, query, hash).Scan(&id, &userID, &tokenHash, &expiresAt, &createdAt, &usedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return user.NewPasswordResetTokenFromStorage(id, userID, tokenHash, expiresAt, createdAt, usedAt), nil
+}
+
+func (r *PasswordResetRepository) Consume(ctx context.Context, t *user.PasswordResetToken) error {
+	usedAt := time.Now()
+	if t.UsedAt != nil {
+		usedAt = *t.UsedAt
+	}
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		// Claiming the token by id makes a concurrent second use see no row.
+		query := `
+			UPDATE password_reset_tokens
+			SET used_at = $2
+			WHERE id = $1 AND used_at IS NULL
+		`
+		tag, err := tx.Exec(ctx, query, t.ID, usedAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return user.ErrInvalidResetToken
+		}
+
+		query = `
+			UPDATE password_reset_tokens
+			SET used_at = $2
+			WHERE user_id = $1 AND used_at IS NULL
+		`
+		_, err = tx.Exec(ctx, query, t.UserID, usedAt)
+		return err
+	})
+}
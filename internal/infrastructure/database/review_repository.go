@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trainer/internal/domain/review"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReviewRepository struct {
+	db *DB
+}
+
+func NewReviewRepository(db *DB) review.Repository {
+	return &ReviewRepository{
+		db: db,
+	}
+}
+
+const reviewStateColumns = `
+	student_id, term_id, deck_id, ease, interval_days, stability, difficulty,
+	reps, lapses, due_at, last_reviewed_at`
+
+func (r *ReviewRepository) FindState(ctx context.Context, studentID, termID uuid.UUID) (*review.State, error) {
+	query := `SELECT ` + reviewStateColumns + ` FROM review_states WHERE student_id = $1 AND term_id = $2`
+
+	state, err := scanReviewState(r.db.pool.QueryRow(ctx, query, studentID, termID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (r *ReviewRepository) FindDue(ctx context.Context, studentID uuid.UUID, deckIDs []uuid.UUID, now time.Time, limit int) ([]*review.State, error) {
+	query := `
+		SELECT ` + reviewStateColumns + `
+		FROM review_states
+		WHERE student_id = $1 AND deck_id = ANY($2) AND due_at <= $3
+			AND EXISTS (SELECT 1 FROM terms t WHERE t.id = term_id AND t.deleted_at IS NULL)
+		ORDER BY due_at, term_id
+		LIMIT $4
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, studentID, deckIDs, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states := make([]*review.State, 0)
+	for rows.Next() {
+		state, err := scanReviewState(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		states = append(states, state)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return states, nil
+}
+
+func (r *ReviewRepository) FindNewTerms(ctx context.Context, studentID, deckID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT t.id
+		FROM terms t
+		WHERE t.deck_id = $2 AND t.deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM review_states s WHERE s.student_id = $1 AND s.term_id = t.id)
+		ORDER BY t.position
+		LIMIT $3
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, studentID, deckID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+func (r *ReviewRepository) Save(ctx context.Context, s *review.State, l *review.Log) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		query := `
+			INSERT INTO review_states (` + reviewStateColumns + `)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (student_id, term_id) DO UPDATE
+			SET deck_id = EXCLUDED.deck_id, ease = EXCLUDED.ease, interval_days = EXCLUDED.interval_days,
+				stability = EXCLUDED.stability, difficulty = EXCLUDED.difficulty, reps = EXCLUDED.reps,
+				lapses = EXCLUDED.lapses, due_at = EXCLUDED.due_at, last_reviewed_at = EXCLUDED.last_reviewed_at
+		`
+		_, err := tx.Exec(ctx, query,
+			s.StudentID, s.TermID, s.DeckID, s.Ease, s.IntervalDays, s.Stability, s.Difficulty,
+			s.Reps, s.Lapses, s.DueAt, s.LastReviewedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		logQuery := `
+			INSERT INTO review_logs (
+				id, student_id, term_id, deck_id, grade, algorithm, interval_days, next_interval_days,
+				duration_ms, reviewed_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`
+		_, err = tx.Exec(ctx, logQuery,
+			l.ID, l.StudentID, l.TermID, l.DeckID, int(l.Grade), l.Algorithm, l.IntervalDays, l.NextIntervalDays,
+			l.Duration.Milliseconds(), l.ReviewedAt,
+		)
+		return err
+	})
+}
+
+func scanReviewState(row pgx.Row) (*review.State, error) {
+	var s review.State
+	err := row.Scan(
+		&s.StudentID, &s.TermID, &s.DeckID, &s.Ease, &s.IntervalDays, &s.Stability, &s.Difficulty,
+		&s.Reps, &s.Lapses, &s.DueAt, &s.LastReviewedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trainer/internal/application"
+)
+
+type RevokedAccessRepository struct {
+	db *DB
+}
+
+func NewRevokedAccessRepository(db *DB) application.RevokedAccessRepository {
+	return &RevokedAccessRepository{
+		db: db,
+	}
+}
+
+func (r *RevokedAccessRepository) Save(ctx context.Context, entry *application.RevokedAccess) error {
+	query := `
+		INSERT INTO revoked_access (key, revoked_at, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE
+		SET revoked_at = EXCLUDED.revoked_at,
+			expires_at = EXCLUDED.expires_at
+	`
+
+	return r.db.Exec(ctx, query, entry.Key, entry.RevokedAt, entry.ExpiresAt)
+}
+
+func (r *RevokedAccessRepository) FindActive(ctx context.Context, now time.Time) ([]*application.RevokedAccess, error) {
+	query := `
+		SELECT key, revoked_at, expires_at
+		FROM revoked_access
+		WHERE expires_at > $1
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*application.RevokedAccess, 0)
+	for rows.Next() {
+		var entry application.RevokedAccess
+		if err := rows.Scan(&entry.Key, &entry.RevokedAt, &entry.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return entries, nil
+}
+
+func (r *RevokedAccessRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	return r.db.Exec(ctx, `DELETE FROM revoked_access WHERE expires_at <= $1`, now)
+}
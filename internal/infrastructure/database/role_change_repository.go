@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trainer/internal/domain/user"
+
+	uuid "github.com/google/uuid"
+)
+
+type RoleChangeRepository struct {
+	db *DB
+}
+
+func NewRoleChangeRepository(db *DB) user.RoleChangeRepository {
+	return &RoleChangeRepository{
+		db: db,
+	}
+}
+
+func (r *RoleChangeRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*user.RoleChange, error) {
+	query := `
+		SELECT id, user_id, actor_id, old_role, new_role, reason, changed_at
+		FROM role_changes
+		WHERE user_id = $1
+		ORDER BY changed_at DESC
+	`
+
+	rows, err := r.db.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]*user.RoleChange, 0)
+	for rows.Next() {
+		var (
+			id        uuid.UUID
+			targetID  uuid.UUID
+			actorID   uuid.UUID
+			oldRole   string
+			newRole   string
+			reason    string
+			changedAt time.Time
+		)
+
+		if err := rows.Scan(&id, &targetID, &actorID, &oldRole, &newRole, &reason, &changedAt); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		changes = append(changes, user.NewRoleChangeFromStorage(id, targetID, actorID, user.Role(oldRole), user.Role(newRole), reason, changedAt))
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sqlWhere collects WHERE conditions together with their positional
+// arguments, so dynamic filters never interpolate user input into SQL.
+type sqlWhere struct {
+	conditions []string
+	args       []any
+}
+
+// arg registers a value and returns its placeholder.
+func (w *sqlWhere) arg(value any) string {
+	w.args = append(w.args, value)
+	return fmt.Sprintf("$%d", len(w.args))
+}
+
+func (w *sqlWhere) add(condition string) {
+	w.conditions = append(w.conditions, condition)
+}
+
+func (w *sqlWhere) String() string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conditions, " AND ")
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes s match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
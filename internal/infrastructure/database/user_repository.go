@@ -2,31 +2,145 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 	"trainer/internal/domain/user"
 
 	uuid "github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+const userColumns = `
+			u.id, u.role, u.email, u.first_name, u.last_name, u.password,
+			u.email_verified_at, u.pending_email, u.mfa_secret, u.mfa_enabled_at, u.mfa_last_step, u.mfa_recovery_codes,
+			u.display_name, u.avatar_key, u.avatar_url, u.locale, u.timezone, u.bio,
+			u.created_at, u.updated_at, u.deleted_at, u.version`
+
+// notDeleted hides soft-deleted users; every finder but
+// FindByIDIncludingDeleted applies it.
+const notDeleted = "u.deleted_at IS NULL"
+
+// uniqueViolation is the Postgres error code raised when a new user takes an
+// email that an active account already has.
+const uniqueViolation = "23505"
+
+const selectUser = `
+		SELECT` + userColumns + `
+		FROM users u
+`
+
 type UserRepository struct {
 	db *DB
 }
 
-func (r *UserRepository) FindAll(ctx context.Context) ([]*user.User, error) {
-	query := `
-		SELECT 
-			u.id, u.role, u.email, u.first_name, u.last_name, u.password, 
-			u.created_at, u.updated_at
-		FROM users u
+func NewUserRepository(db *DB) user.Repository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	query := selectUser + `
+		WHERE id = $1 AND ` + notDeleted
+
+	row := r.db.pool.QueryRow(ctx, query, id)
+
+	return r.scanUser(ctx, row)
+}
+
+func (r *UserRepository) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	query := selectUser + `
+		WHERE id = $1
 	`
 
-	rows, err := r.db.pool.Query(ctx, query)
+	row := r.db.pool.QueryRow(ctx, query, id)
+
+	return r.scanUser(ctx, row)
+}
+
+var userSortColumns = map[user.SortField]string{
+	user.SortByCreatedAt: "u.created_at",
+	user.SortByEmail:     "u.email",
+	user.SortByLastName:  "u.last_name",
+}
+
+func (r *UserRepository) List(ctx context.Context, q user.ListQuery) (*user.UserPage, error) {
+	column, ok := userSortColumns[q.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field %q", q.SortBy)
+	}
+
+	where := &sqlWhere{}
+	where.add(notDeleted)
+	if q.IDs != nil {
+		where.add("u.id = ANY(" + where.arg(q.IDs) + ")")
+	}
+	if q.Role != "" {
+		where.add("u.role = " + where.arg(q.Role))
+	}
+	if q.Search != "" {
+		pattern := where.arg("%" + escapeLike(q.Search) + "%")
+		where.add("(u.email ILIKE " + pattern + " OR u.first_name ILIKE " + pattern + " OR u.last_name ILIKE " + pattern + ")")
+	}
+	if q.CreatedFrom != nil {
+		where.add("u.created_at >= " + where.arg(*q.CreatedFrom))
+	}
+	if q.CreatedTo != nil {
+		where.add("u.created_at < " + where.arg(*q.CreatedTo))
+	}
+
+	var total int
+	if err := r.db.pool.QueryRow(ctx, `SELECT COUNT(*) FROM users u`+where.String(), where.args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	direction, compare := "ASC", ">"
+	if q.Descending {
+		direction, compare = "DESC", "<"
+	}
+
+	if q.After != nil {
+		value, err := cursorValue(q.SortBy, q.After.Value)
+		if err != nil {
+			return nil, user.ErrInvalidCursor
+		}
+		where.add(fmt.Sprintf("(%s, u.id) %s (%s, %s)", column, compare, where.arg(value), where.arg(q.After.ID)))
+	}
+
+	// One extra row tells whether another page follows.
+	query := selectUser + where.String() + fmt.Sprintf(" ORDER BY %s %s, u.id %s LIMIT %s", column, direction, direction, where.arg(q.Limit+1))
+	if q.After == nil && q.Offset > 0 {
+		query += " OFFSET " + where.arg(q.Offset)
+	}
+
+	rows, err := r.db.pool.Query(ctx, query, where.args...)
 	if err != nil {
 		return nil, err
 	}
 
+	users, err := r.collectUsers(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &user.UserPage{Users: users, Total: total}
+	if len(users) > q.Limit {
+		page.Users = users[:q.Limit]
+		last := page.Users[q.Limit-1]
+		page.Next = &user.ListCursor{
+			SortBy:     q.SortBy,
+			Descending: q.Descending,
+			Value:      sortValue(q.SortBy, last),
+			ID:         last.ID,
+		}
+	}
+
+	return page, nil
+}
+
+func (r *UserRepository) collectUsers(ctx context.Context, rows pgx.Rows) ([]*user.User, error) {
 	defer rows.Close()
 
 	users := make([]*user.User, 0)
@@ -38,37 +152,92 @@ func (r *UserRepository) FindAll(ctx context.Context) ([]*user.User, error) {
 		users = append(users, userModel)
 	}
 
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
 	return users, nil
 }
 
-func NewUserRepository(db *DB) user.Repository {
-	return &UserRepository{
-		db: db,
+func sortValue(field user.SortField, u *user.User) string {
+	switch field {
+	case user.SortByEmail:
+		return u.Email
+	case user.SortByLastName:
+		return u.LastName
+	default:
+		return u.CreatedAt.Format(time.RFC3339Nano)
 	}
 }
 
-func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
-	query := `
-		SELECT 
-			u.id, u.role, u.email, u.first_name, u.last_name, u.password, 
-			u.created_at, u.updated_at
-		FROM users u
-		WHERE id = $1
-	`
+func cursorValue(field user.SortField, value string) (any, error) {
+	if field == user.SortByCreatedAt {
+		return time.Parse(time.RFC3339Nano, value)
+	}
+	return value, nil
+}
 
-	row := r.db.pool.QueryRow(ctx, query, id)
+func (r *UserRepository) Search(ctx context.Context, q user.SearchQuery) ([]*user.SearchResult, error) {
+	where := &sqlWhere{}
+	where.add(notDeleted)
+	text := where.arg(q.Text)
+	pattern := where.arg("%" + escapeLike(q.Text) + "%")
+
+	// The expressions must match the trigram indexes for them to be used.
+	name := "(u.first_name || ' ' || u.last_name)"
+	where.add(fmt.Sprintf("(%[1]s <%% %[2]s OR %[1]s <%% u.email OR %[2]s ILIKE %[3]s OR u.email ILIKE %[3]s)", text, name, pattern))
+	if q.IDs != nil {
+		where.add("u.id = ANY(" + where.arg(q.IDs) + ")")
+	}
+	if q.Role != "" {
+		where.add("u.role = " + where.arg(q.Role))
+	}
 
-	return r.scanUser(ctx, row)
+	score := fmt.Sprintf(
+		"GREATEST(word_similarity(%[1]s, %[2]s), word_similarity(%[1]s, u.email), CASE WHEN %[2]s ILIKE %[3]s OR u.email ILIKE %[3]s THEN 1 ELSE 0 END)",
+		text, name, pattern,
+	)
+	query := "SELECT" + userColumns + ", " + score + " AS score FROM users u" +
+		where.String() + " ORDER BY score DESC, u.id LIMIT " + where.arg(q.Limit)
+
+	res, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		// <% matches above this threshold; the default 0.6 misses most typos.
+		_, err := tx.Exec(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, fmt.Sprint(user.SearchThreshold))
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := tx.Query(ctx, query, where.args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		results := make([]*user.SearchResult, 0)
+		for rows.Next() {
+			result := &user.SearchResult{}
+			if result.User, err = r.scanUser(ctx, rows, &result.Score); err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		}
+
+		if rows.Err() != nil {
+			return nil, fmt.Errorf("rows error: %w", rows.Err())
+		}
+
+		return results, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*user.SearchResult), nil
 }
 
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
-	query := `
-		SELECT 
-			u.id, u.role, u.email, u.first_name, u.last_name, u.password, 
-			u.created_at, u.updated_at
-		FROM users u
-		WHERE email = $1
-	`
+	query := selectUser + `
+		WHERE email = $1 AND ` + notDeleted
 
 	row := r.db.pool.QueryRow(ctx, query, email)
 
@@ -76,42 +245,39 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.U
 }
 
 func (r *UserRepository) FindByToken(ctx context.Context, token uuid.UUID) (*user.User, error) {
-	query := `
-		SELECT 
-			u.id, u.role, u.email, u.first_name, u.last_name, u.password, 
-			u.created_at, u.updated_at
-		FROM users u
+	query := selectUser + `
 		LEFT JOIN refresh_tokens ON refresh_tokens.user_id = u.id
-		WHERE refresh_tokens.id = $1
-	`
+		WHERE refresh_tokens.id = $1 AND ` + notDeleted
 
 	row := r.db.pool.QueryRow(ctx, query, token)
 
-	return r.scanUser(ctx, row)
+	// The presented token is loaded even when revoked, so the aggregate can
+	// tell a reused token from an unknown one.
+	return r.scanUserWithToken(ctx, row, token)
 }
 
 func (r *UserRepository) Save(ctx context.Context, u *user.User) error {
 	_, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
-		query := `
-			INSERT INTO users (id, role, email, first_name, last_name, password, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		`
-		_, err := r.db.pool.Exec(ctx, query, u.ID, u.Role, u.Email, u.FirstName, u.LastName, u.Password, u.CreatedAt, u.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
+		return nil, r.insertUser(ctx, tx, u)
+	})
 
-		for _, t := range u.GetRefreshTokens() {
-			query := `
-				INSERT INTO refresh_tokens (id, user_id, expires_at, created_at)
-				VALUES ($1, $2, $3, $4)
-			`
-			_, err := r.db.pool.Exec(ctx, query, t.ID, u.ID, t.ExpiresAt, t.CreatedAt)
-			if err != nil {
+	if err != nil {
+		return err
+	}
+
+	u.MarkStored()
+	return nil
+}
+
+// SaveAll inserts the users in one transaction, so either all of them are
+// stored or none is.
+func (r *UserRepository) SaveAll(ctx context.Context, users []*user.User) error {
+	_, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		for _, u := range users {
+			if err := r.insertUser(ctx, tx, u); err != nil {
 				return nil, err
 			}
 		}
-
 		return nil, nil
 	})
 
@@ -119,63 +285,187 @@ func (r *UserRepository) Save(ctx context.Context, u *user.User) error {
 		return err
 	}
 
+	for _, u := range users {
+		u.MarkStored()
+	}
+	return nil
+}
+
+func (r *UserRepository) insertUser(ctx context.Context, tx pgx.Tx, u *user.User) error {
+	query := `
+		INSERT INTO users (
+			id, role, email, first_name, last_name, password, email_verified_at,
+			mfa_secret, mfa_enabled_at, mfa_last_step, mfa_recovery_codes,
+			display_name, avatar_key, avatar_url, locale, timezone, bio, created_at, updated_at, deleted_at, version,
+			pending_email
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+	`
+	_, err := tx.Exec(ctx, query,
+		u.ID, u.Role, u.Email, u.FirstName, u.LastName, u.Password, u.EmailVerifiedAt,
+		u.MFA.Secret, u.MFA.EnabledAt, u.MFA.LastStep, u.MFA.RecoveryCodes,
+		u.Profile.DisplayName, u.Profile.AvatarKey, u.Profile.AvatarURL, u.Profile.Locale, u.Profile.Timezone, u.Profile.Bio,
+		u.CreatedAt, u.UpdatedAt, u.DeletedAt, u.Version,
+		u.PendingEmail,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return user.ErrEmailAlreadyUsed
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, t := range u.GetRefreshTokens() {
+		if err := r.insertToken(ctx, tx, u.ID, t); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 	_, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		if u.LeavesAdmins() {
+			if err := ensureOtherAdmin(ctx, tx, u.ID); err != nil {
+				return nil, err
+			}
+		}
+
 		query := `
 			UPDATE users
-			SET role=$2, email=$3, first_name=$4, last_name=$5, password=$6
-			WHERE id=$1
+			SET role=$2, email=$3, first_name=$4, last_name=$5, password=$6, email_verified_at=$7,
+				mfa_secret=CASE WHEN $21 THEN $8 ELSE mfa_secret END,
+				mfa_enabled_at=CASE WHEN $21 THEN $9 ELSE mfa_enabled_at END,
+				mfa_last_step=CASE WHEN $21 THEN $10 ELSE mfa_last_step END,
+				mfa_recovery_codes=CASE WHEN $21 THEN COALESCE($11::text[], '{}') ELSE mfa_recovery_codes END,
+				deleted_at=$12, updated_at=$13, display_name=$15, avatar_key=$16, avatar_url=$17, locale=$18,
+				timezone=$19, bio=$20, pending_email=$22, version=version + 1
+			WHERE id=$1 AND version=$14
 		`
-		_, err := r.db.pool.Exec(ctx, query, u.ID, u.Role, u.Email, u.FirstName, u.LastName, u.Password)
+		// The MFA columns are only written when u changed them: a login may
+		// have spent a recovery code since u was loaded.
+		tag, err := tx.Exec(ctx, query,
+			u.ID, u.Role, u.Email, u.FirstName, u.LastName, u.Password, u.EmailVerifiedAt,
+			u.MFA.Secret, u.MFA.EnabledAt, u.MFA.LastStep, u.MFA.RecoveryCodes, u.DeletedAt,
+			u.UpdatedAt, u.Version,
+			u.Profile.DisplayName, u.Profile.AvatarKey, u.Profile.AvatarURL, u.Profile.Locale, u.Profile.Timezone, u.Profile.Bio,
+			u.MFAChanged(), u.PendingEmail,
+		)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, user.ErrEmailAlreadyUsed
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		existedTokens, err := r.findTokensByUserId(ctx, u.ID)
-		if err != nil {
-			return nil, err
+		if tag.RowsAffected() == 0 {
+			var exists bool
+			if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, u.ID).Scan(&exists); err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, user.ErrVersionConflict
+			}
+			return nil, user.ErrUserNotFound
 		}
 
-		mappedExistedTokens := make(map[uuid.UUID]*user.RefreshToken, len(existedTokens))
-		for _, t := range existedTokens {
-			mappedExistedTokens[t.ID] = t
+		if err := saveRoleChanges(ctx, tx, u.RoleChanges()); err != nil {
+			return nil, err
 		}
 
-		for _, t := range u.GetRevokedTokens() {
-			_, err = tx.Exec(ctx, `DELETE FROM refresh_tokens WHERE id=$1`, t.ID)
+		return nil, r.saveTokens(ctx, tx, u)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	u.Version++
+	u.MarkStored()
+	return nil
+}
+
+func (r *UserRepository) UpdateSessions(ctx context.Context, u *user.User) error {
+	_, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		if u.MFAChanged() {
+			// Compare-and-set: a concurrent login that spent the same code
+			// or time step leaves no row to update.
+			stored := u.StoredMFA()
+			query := `
+				UPDATE users
+				SET mfa_last_step=$2, mfa_recovery_codes=COALESCE($3::text[], '{}')
+				WHERE id=$1 AND deleted_at IS NULL
+					AND mfa_last_step=$4 AND mfa_recovery_codes=COALESCE($5::text[], '{}')
+			`
+			tag, err := tx.Exec(ctx, query, u.ID, u.MFA.LastStep, u.MFA.RecoveryCodes, stored.LastStep, stored.RecoveryCodes)
 			if err != nil {
 				return nil, err
 			}
-		}
-
-		for _, t := range u.GetRefreshTokens() {
-			if mappedExistedTokens[t.ID] == nil {
-				query := `
-				INSERT INTO refresh_tokens (id, user_id, expires_at, created_at)
-				VALUES ($1, $2, $3, $4)
-			`
-				_, err := r.db.pool.Exec(ctx, query, t.ID, u.ID, t.ExpiresAt, t.CreatedAt)
-				if err != nil {
-					return nil, err
-				}
+			if tag.RowsAffected() == 0 {
+				return nil, user.ErrInvalidMFACode
+			}
+		} else {
+			var exists bool
+			query := `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)`
+			if err := tx.QueryRow(ctx, query, u.ID).Scan(&exists); err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, user.ErrUserNotFound
 			}
 		}
 
-		return nil, nil
+		return nil, r.saveTokens(ctx, tx, u)
 	})
 
 	if err != nil {
 		return err
 	}
 
+	u.MarkStored()
+	return nil
+}
+
+// saveTokens inserts the refresh tokens issued to u and stores the
+// revocations. A stored token that is already revoked was rotated or revoked
+// concurrently, and then ErrVersionConflict is returned.
+func (r *UserRepository) saveTokens(ctx context.Context, tx pgx.Tx, u *user.User) error {
+	issued := make(map[uuid.UUID]bool)
+	for _, t := range u.NewRefreshTokens() {
+		issued[t.ID] = true
+		if err := r.insertToken(ctx, tx, u.ID, t); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range u.NewlyRevokedTokens() {
+		if issued[t.ID] {
+			continue
+		}
+
+		query := `UPDATE refresh_tokens SET revoked_at=$2 WHERE id=$1 AND revoked_at IS NULL`
+		tag, err := tx.Exec(ctx, query, t.ID, t.RevokedAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return user.ErrVersionConflict
+		}
+	}
+
 	return nil
 }
 
 func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	_, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		if err := ensureOtherAdmin(ctx, tx, id); err != nil {
+			return nil, err
+		}
+
 		queryTokens := `DELETE FROM refresh_tokens WHERE user_id=$1`
 		_, err := tx.Exec(ctx, queryTokens, id)
 		if err != nil {
@@ -198,7 +488,116 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (r *UserRepository) scanUser(ctx context.Context, row pgx.Row) (*user.User, error) {
+func (r *UserRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]user.PurgedUser, error) {
+	res, err := r.trx(ctx, func(tx pgx.Tx) (any, error) {
+		queryTokens := `
+			DELETE FROM refresh_tokens
+			WHERE user_id IN (SELECT id FROM users WHERE deleted_at < $1)
+		`
+		if _, err := tx.Exec(ctx, queryTokens, before); err != nil {
+			return nil, err
+		}
+
+		rows, err := tx.Query(ctx, `DELETE FROM users WHERE deleted_at < $1 RETURNING id, avatar_key`, before)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var purged []user.PurgedUser
+		for rows.Next() {
+			var p user.PurgedUser
+			if err := rows.Scan(&p.ID, &p.AvatarKey); err != nil {
+				return nil, err
+			}
+			purged = append(purged, p)
+		}
+
+		return purged, rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]user.PurgedUser), nil
+}
+
+func (r *UserRepository) PurgeExpiredTokens(ctx context.Context, now time.Time) (int, error) {
+	// Once the newest token of a family expired none of it can be presented
+	// successfully, so reuse detection no longer needs the family.
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE family_id IN (
+			SELECT family_id FROM refresh_tokens
+			GROUP BY family_id
+			HAVING MAX(expires_at) < $1
+		)
+	`
+	tag, err := r.db.pool.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// ensureOtherAdmin returns ErrLastAdmin when id is the only active admin. It
+// locks the admin rows, so that concurrent demotions wait for each other
+// and the later one counts without the earlier.
+func ensureOtherAdmin(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	rows, err := tx.Query(ctx, `SELECT id FROM users u WHERE role = $1 AND `+notDeleted+` FOR UPDATE`, user.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	others := 0
+	isAdmin := false
+	for rows.Next() {
+		var adminID uuid.UUID
+		if err := rows.Scan(&adminID); err != nil {
+			return err
+		}
+		if adminID == id {
+			isAdmin = true
+		} else {
+			others++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if isAdmin && others == 0 {
+		return user.ErrLastAdmin
+	}
+
+	return nil
+}
+
+func saveRoleChanges(ctx context.Context, tx pgx.Tx, changes []*user.RoleChange) error {
+	query := `
+		INSERT INTO role_changes (id, user_id, actor_id, old_role, new_role, reason, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	for _, c := range changes {
+		if _, err := tx.Exec(ctx, query, c.ID, c.UserID, c.ActorID, c.OldRole, c.NewRole, c.Reason, c.ChangedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanUser reads the selectUser columns followed by any extra ones.
+func (r *UserRepository) scanUser(ctx context.Context, row pgx.Row, extra ...any) (*user.User, error) {
+	return r.scanUserWithToken(ctx, row, uuid.Nil, extra...)
+}
+
+// scanUserWithToken is scanUser that also loads the given refresh token
+// whatever its state; other tokens are only loaded while active.
+func (r *UserRepository) scanUserWithToken(ctx context.Context, row pgx.Row, token uuid.UUID, extra ...any) (*user.User, error) {
 	var (
 		id           uuid.UUID
 		role         string
@@ -206,14 +605,23 @@ func (r *UserRepository) scanUser(ctx context.Context, row pgx.Row) (*user.User,
 		firstName    string
 		lastName     string
 		passwordHash string
+		verifiedAt   *time.Time
+		pendingEmail string
+		mfa          user.MFASettings
+		profile      user.Profile
 		createdAt    time.Time
 		updatedAt    time.Time
+		deletedAt    *time.Time
+		version      int
 	)
 
-	err := row.Scan(
+	dest := []any{
 		&id, &role, &email, &firstName, &lastName, &passwordHash,
-		&createdAt, &updatedAt,
-	)
+		&verifiedAt, &pendingEmail, &mfa.Secret, &mfa.EnabledAt, &mfa.LastStep, &mfa.RecoveryCodes,
+		&profile.DisplayName, &profile.AvatarKey, &profile.AvatarURL, &profile.Locale, &profile.Timezone, &profile.Bio,
+		&createdAt, &updatedAt, &deletedAt, &version,
+	}
+	err := row.Scan(append(dest, extra...)...)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -223,33 +631,41 @@ func (r *UserRepository) scanUser(ctx context.Context, row pgx.Row) (*user.User,
 		return nil, err
 	}
 
-	tokens, err := r.findTokensByUserId(ctx, id)
+	tokens, err := r.findTokens(ctx, id, token)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return user.NewUserFromStorage(
+	u := user.NewUserFromStorage(
 		id,
 		email,
 		firstName,
 		lastName,
 		passwordHash,
 		user.Role(role),
+		verifiedAt,
+		mfa,
+		profile,
 		createdAt,
 		updatedAt,
+		deletedAt,
+		version,
 		tokens,
-	), nil
+	)
+	u.PendingEmail = pendingEmail
+
+	return u, nil
 }
 
-func (r *UserRepository) findTokensByUserId(ctx context.Context, userId uuid.UUID) ([]*user.RefreshToken, error) {
+func (r *UserRepository) findTokens(ctx context.Context, userId, token uuid.UUID) ([]*user.RefreshToken, error) {
 	query := `
-		SELECT id, expires_at, created_at
+		SELECT id, family_id, user_agent, ip, expires_at, created_at, revoked_at
 		FROM refresh_tokens
-		WHERE user_id=$1
+		WHERE user_id=$1 AND ((revoked_at IS NULL AND expires_at > now()) OR id=$2)
 	`
 
-	rows, err := r.db.pool.Query(ctx, query, userId)
+	rows, err := r.db.pool.Query(ctx, query, userId, token)
 	if err != nil {
 		return nil, err
 	}
@@ -259,18 +675,22 @@ func (r *UserRepository) findTokensByUserId(ctx context.Context, userId uuid.UUI
 	for rows.Next() {
 		var (
 			tokenID      uuid.UUID
+			familyID     uuid.UUID
+			userAgent    string
+			ip           string
 			tokenCreated time.Time
 			tokenExpires time.Time
+			tokenRevoked *time.Time
 		)
 
 		err := rows.Scan(
-			&tokenID, &tokenExpires, &tokenCreated,
+			&tokenID, &familyID, &userAgent, &ip, &tokenExpires, &tokenCreated, &tokenRevoked,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan row: %w", err)
 		}
 
-		tokens = append(tokens, user.NewRefreshTokenFromStorage(tokenID, tokenExpires, tokenCreated))
+		tokens = append(tokens, user.NewRefreshTokenFromStorage(tokenID, familyID, userAgent, ip, tokenExpires, tokenCreated, tokenRevoked))
 	}
 
 	if rows.Err() != nil {
@@ -280,6 +700,15 @@ func (r *UserRepository) findTokensByUserId(ctx context.Context, userId uuid.UUI
 	return tokens, nil
 }
 
+func (r *UserRepository) insertToken(ctx context.Context, tx pgx.Tx, userID uuid.UUID, t *user.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (id, user_id, family_id, user_agent, ip, expires_at, created_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := tx.Exec(ctx, query, t.ID, userID, t.FamilyID, t.UserAgent, t.IP, t.ExpiresAt, t.CreatedAt, t.RevokedAt)
+	return err
+}
+
 func (r *UserRepository) trx(ctx context.Context, call func(tx pgx.Tx) (any, error)) (any, error) {
 	tx, err := r.db.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
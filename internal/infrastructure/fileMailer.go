@@ -0,0 +1,51 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+	"trainer/internal/application"
+
+	"github.com/google/uuid"
+)
+
+// FileMailer delivers messages for local runs: each one is written as an
+// .eml file into dir, or to the log when dir is empty.
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+func NewFileMailer(from, dir string) application.Mailer {
+	return &FileMailer{
+		from: from,
+		dir:  dir,
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg application.Message) error {
+	now := time.Now()
+	content := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		m.from, msg.To, msg.Subject, now.Format(time.RFC1123Z), msg.Body,
+	)
+
+	if m.dir == "" {
+		log.Printf("mail to %s:\n%s", msg.To, content)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o750); err != nil {
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405"), uuid.NewString())
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(content), 0o600); err != nil {
+		return fmt.Errorf("write mail: %w", err)
+	}
+
+	return nil
+}
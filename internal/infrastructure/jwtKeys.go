@@ -0,0 +1,118 @@
+package infrastructure
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSABits = 2048
+
+// JwtKey is a signing or verification key identified by its kid.
+// Private is nil for keys that are only kept to verify tokens issued
+// before a rotation.
+type JwtKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.PrivateKey
+	Public  crypto.PublicKey
+}
+
+// NewHMACKey wraps a shared secret as an HS256 key. HMAC keys can both sign
+// and verify, and are never published in the JWKS.
+func NewHMACKey(id, secret string) JwtKey {
+	return JwtKey{
+		ID:      id,
+		Method:  jwt.SigningMethodHS256,
+		Private: []byte(secret),
+		Public:  []byte(secret),
+	}
+}
+
+// LoadJwtKeys reads every <kid>.pem file in dir. A file may hold a PKCS#8 or
+// PKCS#1 private key, or a PKIX public key for a retired signing key.
+// RSA keys sign with RS256 and Ed25519 keys with EdDSA.
+func LoadJwtKeys(dir string) ([]JwtKey, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("list jwt keys: %w", err)
+	}
+	sort.Strings(paths)
+
+	keys := make([]JwtKey, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read jwt key: %w", err)
+		}
+
+		id := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := parseJwtKey(id, data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", id, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no jwt keys found in %s", dir)
+	}
+
+	return keys, nil
+}
+
+func parseJwtKey(id string, data []byte) (JwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return JwtKey{}, errors.New("no PEM block found")
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return JwtKey{}, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return JwtKey{}, err
+	}
+
+	key := JwtKey{ID: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodEdDSA, k
+	default:
+		return JwtKey{}, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	if pub, ok := key.Public.(*rsa.PublicKey); ok && pub.N.BitLen() < minRSABits {
+		return JwtKey{}, fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+	}
+
+	return key, nil
+}
@@ -1,84 +1,190 @@
 package infrastructure
 
 import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 	"time"
 	"trainer/internal/application"
 	"trainer/internal/domain/user"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+type JwtOptions struct {
+	// Keys are all keys tokens are accepted from; ActiveKeyID picks the one
+	// new tokens are signed with.
+	Keys        []JwtKey
+	ActiveKeyID string
+	Issuer      string
+	Audience    string
+	TTL         time.Duration
+}
+
 type JwtManager struct {
-	secretKey string
-	ttl       time.Duration
+	active   JwtKey
+	keys     map[string]JwtKey
+	methods  []string
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+type accessClaims struct {
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
+	jwt.RegisteredClaims
 }
 
-func NewJwtManager(secretKey string, ttl time.Duration) application.TokenManager {
-	return &JwtManager{
-		secretKey: secretKey,
-		ttl:       ttl,
+func NewJwtManager(options JwtOptions) (*JwtManager, error) {
+	m := &JwtManager{
+		keys:     make(map[string]JwtKey, len(options.Keys)),
+		issuer:   options.Issuer,
+		audience: options.Audience,
+		ttl:      options.TTL,
 	}
+
+	seen := make(map[string]bool)
+	for _, key := range options.Keys {
+		if _, ok := m.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate jwt key id %q", key.ID)
+		}
+		m.keys[key.ID] = key
+
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			m.methods = append(m.methods, alg)
+		}
+	}
+
+	active, ok := m.keys[options.ActiveKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active jwt key %q not found", options.ActiveKeyID)
+	}
+	if active.Private == nil {
+		return nil, fmt.Errorf("active jwt key %q has no private key", options.ActiveKeyID)
+	}
+	m.active = active
+
+	return m, nil
 }
 
 func (m *JwtManager) Generate(claim application.TokenClaim) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": claim.UserID.String(),
-		"role":    string(claim.Role),
-		"exp":     time.Now().Add(m.ttl).Unix(),
+	now := time.Now()
+	claims := accessClaims{
+		Role: string(claim.Role),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    m.issuer,
+			Subject:   claim.UserID.String(),
+			Audience:  jwt.ClaimStrings{m.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttl)),
+		},
+	}
+
+	if claim.SessionID != uuid.Nil {
+		claims.SessionID = claim.SessionID.String()
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+
+	token := jwt.NewWithClaims(m.active.Method, claims)
+	token.Header["kid"] = m.active.ID
+
+	return token.SignedString(m.active.Private)
 }
 
 func (m *JwtManager) Parse(tokenStr string) (*application.TokenClaim, error) {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.secretKey), nil
-	})
-
+	claims := &accessClaims{}
+	_, err := jwt.ParseWithClaims(tokenStr, claims, m.verificationKey,
+		jwt.WithValidMethods(m.methods),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithAudience(m.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("parse token: %w", err)
 	}
 
-	if !token.Valid {
-		return nil, errors.New("invalid token")
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sub format: %w", err)
+	}
+
+	if claims.Role == "" {
+		return nil, errors.New("role claim missing")
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errors.New("invalid claims type")
+	if claims.IssuedAt == nil {
+		return nil, errors.New("iat claim missing")
 	}
 
-	if exp, ok := claims["exp"].(float64); ok {
-		if time.Unix(int64(exp), 0).Before(time.Now()) {
-			return nil, errors.New("token expired")
+	var sessionID uuid.UUID
+	if claims.SessionID != "" {
+		if sessionID, err = uuid.Parse(claims.SessionID); err != nil {
+			return nil, fmt.Errorf("invalid sid format: %w", err)
 		}
-	} else {
-		return nil, errors.New("exp claim missing or invalid")
 	}
 
-	userID, ok := claims["user_id"].(string)
+	return &application.TokenClaim{
+		ID:        claims.ID,
+		UserID:    userID,
+		Role:      user.Role(claims.Role),
+		SessionID: sessionID,
+		IssuedAt:  claims.IssuedAt.Time,
+	}, nil
+}
+
+// verificationKey resolves the kid header so tokens signed with a key that
+// has since been rotated out of signing still verify.
+func (m *JwtManager) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := m.keys[kid]
 	if !ok {
-		return nil, errors.New("user_id claim is not a string")
+		return nil, fmt.Errorf("unknown key id %q", kid)
 	}
 
-	role, ok := claims["role"].(string)
-	if !ok {
-		return nil, errors.New("role claim is not a string")
+	if key.Method.Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %v for key %q", token.Header["alg"], kid)
 	}
 
-	parsedUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid user_id format: %w", err)
+	return key.Public, nil
+}
+
+func (m *JwtManager) JWKS() application.JWKS {
+	set := application.JWKS{Keys: make([]application.JWK, 0, len(m.keys))}
+
+	for _, key := range m.keys {
+		jwk := application.JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+
+		switch pub := key.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = encodeJWK(pub.N.Bytes())
+			jwk.E = encodeJWK(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = encodeJWK(pub)
+		default:
+			// Shared secrets must never be published.
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
 	}
 
-	return &application.TokenClaim{
-		UserID: parsedUUID,
-		Role:   user.Role(role),
-	}, nil
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+
+	return set
+}
+
+func encodeJWK(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
 }
@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"trainer/internal/application"
+)
+
+var errInvalidStorageKey = errors.New("invalid storage key")
+
+// LocalStorage keeps files in a directory on the local disk, which is served
+// at baseURL.
+type LocalStorage struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalStorage(dir, baseURL string) application.FileStorage {
+	return &LocalStorage{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// Save writes to a temporary file first, so a failed or oversized upload
+// never leaves a partial file behind.
+func (s *LocalStorage) Save(ctx context.Context, key string, content io.Reader) (string, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return "", fmt.Errorf("create storage dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return "", fmt.Errorf("store file: %w", err)
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete file: %w", err)
+	}
+
+	return nil
+}
+
+// path maps key into dir, refusing keys that would escape it.
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" || path.Clean("/"+key) != "/"+key {
+		return "", errInvalidStorageKey
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
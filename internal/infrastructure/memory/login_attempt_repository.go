@@ -0,0 +1,68 @@
+// Package memory provides in-process implementations of the domain
+// repositories, used by tests and single-instance local runs
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+	"trainer/internal/domain/user"
+)
+
+type LoginAttemptRepository struct {
+	mu       sync.Mutex
+	attempts map[string]user.LoginAttempts
+}
+
+func NewLoginAttemptRepository() user.LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		attempts: make(map[string]user.LoginAttempts),
+	}
+}
+
+func (r *LoginAttemptRepository) Find(ctx context.Context, key string) (*user.LoginAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return &a, nil
+}
+
+func (r *LoginAttemptRepository) Increment(ctx context.Context, key string, at, forgetBefore time.Time) (*user.LoginAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.attempts[key]
+	if !ok || a.IsStale(at, forgetBefore) {
+		a = user.LoginAttempts{Key: key}
+	}
+
+	a.Failures++
+	a.LastFailureAt = at
+	r.attempts[key] = a
+
+	return &a, nil
+}
+
+func (r *LoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if a, ok := r.attempts[key]; ok {
+		a.LockedUntil = &until
+		r.attempts[key] = a
+	}
+	return nil
+}
+
+func (r *LoginAttemptRepository) Delete(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
@@ -0,0 +1,417 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"trainer/internal/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]*user.User
+}
+
+func NewUserRepository() user.Repository {
+	return &UserRepository{
+		users: make(map[uuid.UUID]*user.User),
+	}
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.users[id]
+	if u == nil || u.IsDeleted() {
+		return nil, nil
+	}
+	return copyUser(u), nil
+}
+
+func (r *UserRepository) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.users[id]
+	if u == nil {
+		return nil, nil
+	}
+	return copyUser(u), nil
+}
+
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.Email == email && !u.IsDeleted() {
+			return copyUser(u), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *UserRepository) FindByToken(ctx context.Context, token uuid.UUID) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.IsDeleted() {
+			continue
+		}
+		tokens := append(u.GetRefreshTokens(), u.GetRevokedTokens()...)
+		for _, t := range tokens {
+			if t.ID == token {
+				return copyUser(u), nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *UserRepository) List(ctx context.Context, q user.ListQuery) (*user.UserPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	search := strings.ToLower(q.Search)
+	matched := make([]*user.User, 0)
+	for _, u := range r.users {
+		if u.IsDeleted() || q.Role != "" && u.Role != q.Role {
+			continue
+		}
+		if q.IDs != nil && !slices.Contains(q.IDs, u.ID) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(u.Email+"\n"+u.FirstName+"\n"+u.LastName), search) {
+			continue
+		}
+		if q.CreatedFrom != nil && u.CreatedAt.Before(*q.CreatedFrom) {
+			continue
+		}
+		if q.CreatedTo != nil && !u.CreatedAt.Before(*q.CreatedTo) {
+			continue
+		}
+		matched = append(matched, copyUser(u))
+	}
+
+	compare := func(a, b *user.User) int {
+		c := compareSortValue(q.SortBy, a, b)
+		if c == 0 {
+			c = strings.Compare(a.ID.String(), b.ID.String())
+		}
+		if q.Descending {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(matched, compare)
+
+	page := &user.UserPage{Total: len(matched)}
+
+	start := min(q.Offset, len(matched))
+	if q.After != nil {
+		start = len(matched)
+		for i, u := range matched {
+			if compareToCursor(q, u) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	end := min(start+q.Limit, len(matched))
+	page.Users = matched[start:end]
+
+	if end < len(matched) && len(page.Users) > 0 {
+		last := page.Users[len(page.Users)-1]
+		page.Next = &user.ListCursor{
+			SortBy:     q.SortBy,
+			Descending: q.Descending,
+			Value:      sortValue(q.SortBy, last),
+			ID:         last.ID,
+		}
+	}
+
+	return page, nil
+}
+
+func (r *UserRepository) Search(ctx context.Context, q user.SearchQuery) ([]*user.SearchResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	results := make([]*user.SearchResult, 0)
+	for _, u := range r.users {
+		if u.IsDeleted() || q.Role != "" && u.Role != q.Role {
+			continue
+		}
+		if q.IDs != nil && !slices.Contains(q.IDs, u.ID) {
+			continue
+		}
+
+		score := max(
+			user.WordSimilarity(q.Text, u.FirstName+" "+u.LastName),
+			user.WordSimilarity(q.Text, u.Email),
+		)
+		if score < user.SearchThreshold {
+			continue
+		}
+
+		results = append(results, &user.SearchResult{User: copyUser(u), Score: score})
+	}
+
+	slices.SortFunc(results, func(a, b *user.SearchResult) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.User.ID.String(), b.User.ID.String())
+	})
+
+	if len(results) > q.Limit {
+		results = results[:q.Limit]
+	}
+
+	return results, nil
+}
+
+func (r *UserRepository) Save(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.Email == u.Email && !existing.IsDeleted() {
+			return user.ErrEmailAlreadyUsed
+		}
+	}
+
+	r.users[u.ID] = copyUser(u)
+	u.MarkStored()
+	return nil
+}
+
+func (r *UserRepository) SaveAll(ctx context.Context, users []*user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	taken := make(map[string]bool)
+	for _, existing := range r.users {
+		if !existing.IsDeleted() {
+			taken[existing.Email] = true
+		}
+	}
+	for _, u := range users {
+		if taken[u.Email] {
+			return user.ErrEmailAlreadyUsed
+		}
+		taken[u.Email] = true
+	}
+
+	for _, u := range users {
+		r.users[u.ID] = copyUser(u)
+		u.MarkStored()
+	}
+	return nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[u.ID]
+	if !ok {
+		return user.ErrUserNotFound
+	}
+	if stored.Version != u.Version {
+		return user.ErrVersionConflict
+	}
+	if u.LeavesAdmins() && r.isLastAdmin(u.ID) {
+		return user.ErrLastAdmin
+	}
+
+	updated := copyUser(u)
+	if !u.MFAChanged() {
+		updated.MFA = stored.MFA
+	}
+	updated.Version++
+	r.users[u.ID] = updated
+	u.Version = updated.Version
+	u.MarkStored()
+	return nil
+}
+
+func (r *UserRepository) UpdateSessions(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[u.ID]
+	if !ok || stored.IsDeleted() {
+		return user.ErrUserNotFound
+	}
+
+	mfa := stored.MFA
+	if u.MFAChanged() {
+		mfa.LastStep = u.MFA.LastStep
+		mfa.RecoveryCodes = u.MFA.RecoveryCodes
+	}
+
+	updated := user.NewUserFromStorage(
+		stored.ID, stored.Email, stored.FirstName, stored.LastName, stored.Password, stored.Role,
+		stored.EmailVerifiedAt, mfa, stored.Profile, stored.CreatedAt, stored.UpdatedAt, stored.DeletedAt,
+		stored.Version, append(u.GetRefreshTokens(), u.GetRevokedTokens()...),
+	)
+	updated.PendingEmail = stored.PendingEmail
+	r.users[u.ID] = copyUser(updated)
+	u.MarkStored()
+	return nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.isLastAdmin(id) {
+		return user.ErrLastAdmin
+	}
+
+	delete(r.users, id)
+	return nil
+}
+
+// isLastAdmin tells whether id is the only active admin. The caller holds
+// the lock.
+func (r *UserRepository) isLastAdmin(id uuid.UUID) bool {
+	isAdmin := false
+	for _, u := range r.users {
+		if !u.IsAdmin() || u.IsDeleted() {
+			continue
+		}
+		if u.ID != id {
+			return false
+		}
+		isAdmin = true
+	}
+	return isAdmin
+}
+
+func (r *UserRepository) PurgeExpiredTokens(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := 0
+	for id, u := range r.users {
+		tokens := append(u.GetRefreshTokens(), u.GetRevokedTokens()...)
+
+		newest := make(map[uuid.UUID]time.Time)
+		for _, t := range tokens {
+			if t.ExpiresAt.After(newest[t.FamilyID]) {
+				newest[t.FamilyID] = t.ExpiresAt
+			}
+		}
+
+		kept := tokens[:0]
+		for _, t := range tokens {
+			if newest[t.FamilyID].Before(now) {
+				purged++
+				continue
+			}
+			kept = append(kept, t)
+		}
+
+		purgedUser := user.NewUserFromStorage(
+			u.ID, u.Email, u.FirstName, u.LastName, u.Password, u.Role,
+			u.EmailVerifiedAt, u.MFA, u.Profile, u.CreatedAt, u.UpdatedAt, u.DeletedAt,
+			u.Version, kept,
+		)
+		purgedUser.PendingEmail = u.PendingEmail
+		r.users[id] = purgedUser
+	}
+
+	return purged, nil
+}
+
+func (r *UserRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]user.PurgedUser, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged []user.PurgedUser
+	for id, u := range r.users {
+		if u.DeletedAt != nil && u.DeletedAt.Before(before) {
+			delete(r.users, id)
+			purged = append(purged, user.PurgedUser{ID: id, AvatarKey: u.Profile.AvatarKey})
+		}
+	}
+
+	return purged, nil
+}
+
+// copyUser rebuilds u the way it would be loaded, so that callers never
+// share state with the stored user.
+func copyUser(u *user.User) *user.User {
+	tokens := make([]*user.RefreshToken, 0)
+	for _, t := range append(u.GetRefreshTokens(), u.GetRevokedTokens()...) {
+		copied := *t
+		tokens = append(tokens, &copied)
+	}
+
+	mfa := u.MFA
+	mfa.RecoveryCodes = slices.Clone(u.MFA.RecoveryCodes)
+
+	copied := user.NewUserFromStorage(
+		u.ID, u.Email, u.FirstName, u.LastName, u.Password, u.Role,
+		u.EmailVerifiedAt, mfa, u.Profile, u.CreatedAt, u.UpdatedAt, u.DeletedAt,
+		u.Version, tokens,
+	)
+	copied.PendingEmail = u.PendingEmail
+	return copied
+}
+
+func compareSortValue(field user.SortField, a, b *user.User) int {
+	switch field {
+	case user.SortByEmail:
+		return strings.Compare(a.Email, b.Email)
+	case user.SortByLastName:
+		return strings.Compare(a.LastName, b.LastName)
+	default:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	}
+}
+
+// compareToCursor orders u against the cursor position in the query's sort
+// direction; positive means u comes after it.
+func compareToCursor(q user.ListQuery, u *user.User) int {
+	var c int
+	switch q.SortBy {
+	case user.SortByEmail:
+		c = strings.Compare(u.Email, q.After.Value)
+	case user.SortByLastName:
+		c = strings.Compare(u.LastName, q.After.Value)
+	default:
+		at, _ := time.Parse(time.RFC3339Nano, q.After.Value)
+		c = u.CreatedAt.Compare(at)
+	}
+	if c == 0 {
+		c = strings.Compare(u.ID.String(), q.After.ID.String())
+	}
+	if q.Descending {
+		return -c
+	}
+	return c
+}
+
+func sortValue(field user.SortField, u *user.User) string {
+	switch field {
+	case user.SortByEmail:
+		return u.Email
+	case user.SortByLastName:
+		return u.LastName
+	default:
+		return u.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
@@ -0,0 +1,64 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"trainer/internal/domain/user"
+	"trainer/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+)
+
+func saveUser(t *testing.T, repo user.Repository, email, firstName, lastName string, role user.Role, deletedAt *time.Time) *user.User {
+	t.Helper()
+	u := user.NewUserFromStorage(uuid.New(), email, firstName, lastName, "", role,
+		nil, user.MFASettings{}, user.Profile{}, time.Now(), time.Now(), deletedAt, 1, nil)
+	if err := repo.Save(context.Background(), u); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return u
+}
+
+func TestUserRepositorySearch(t *testing.T) {
+	repo := memory.NewUserRepository()
+	deletedAt := time.Now()
+
+	ann := saveUser(t, repo, "ann@example.com", "Ann", "Lee", user.RoleStudent, nil)
+	anna := saveUser(t, repo, "anna@example.com", "Anna", "Berg", user.RoleMentor, nil)
+	bob := saveUser(t, repo, "bob@example.com", "Bob", "Ray", user.RoleStudent, nil)
+	saveUser(t, repo, "anne@example.com", "Anne", "Gone", user.RoleStudent, &deletedAt)
+
+	tests := []struct {
+		name  string
+		query user.SearchQuery
+		want  []*user.User
+	}{
+		{"best match first", user.SearchQuery{Text: "anne", Limit: 10}, []*user.User{ann, anna}},
+		{"below the threshold", user.SearchQuery{Text: "zed", Limit: 10}, nil},
+		{"role", user.SearchQuery{Text: "anne", Role: user.RoleMentor, Limit: 10}, []*user.User{anna}},
+		{"ids", user.SearchQuery{Text: "anne", IDs: []uuid.UUID{anna.ID, bob.ID}, Limit: 10}, []*user.User{anna}},
+		{"empty ids", user.SearchQuery{Text: "anne", IDs: []uuid.UUID{}, Limit: 10}, nil},
+		{"limit", user.SearchQuery{Text: "anne", Limit: 1}, []*user.User{ann}},
+	}
+
+	for _, tt := range tests {
+		results, err := repo.Search(context.Background(), tt.query)
+		if err != nil {
+			t.Fatalf("%s: Search: %v", tt.name, err)
+		}
+
+		if len(results) != len(tt.want) {
+			t.Errorf("%s: got %d results, want %d", tt.name, len(results), len(tt.want))
+			continue
+		}
+		for i, r := range results {
+			if r.User.ID != tt.want[i].ID {
+				t.Errorf("%s: result %d is %s, want %s", tt.name, i, r.User.Email, tt.want[i].Email)
+			}
+			if r.Score < user.SearchThreshold {
+				t.Errorf("%s: %s scored %v, below the threshold", tt.name, r.User.Email, r.Score)
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"trainer/internal/application"
+
+	"github.com/google/uuid"
+)
+
+// TokenDenylist keeps the active denylist entries in memory so AuthMiddleware
+// does not hit the database on every request. The cache is reloaded from the
+// repository every syncInterval, which bounds how long another instance's
+// revocation takes to reach this one.
+type TokenDenylist struct {
+	repo         application.RevokedAccessRepository
+	ttl          time.Duration
+	syncInterval time.Duration
+
+	mu       sync.RWMutex
+	entries  map[string]time.Time
+	syncedAt time.Time
+	syncMu   sync.Mutex
+}
+
+// NewTokenDenylist keeps entries for ttl, the lifetime of an access token.
+func NewTokenDenylist(repo application.RevokedAccessRepository, ttl, syncInterval time.Duration) *TokenDenylist {
+	return &TokenDenylist{
+		repo:         repo,
+		ttl:          ttl,
+		syncInterval: syncInterval,
+		entries:      make(map[string]time.Time),
+	}
+}
+
+func (d *TokenDenylist) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	return d.revoke(ctx, sessionKey(sessionID))
+}
+
+func (d *TokenDenylist) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	return d.revoke(ctx, userKey(userID))
+}
+
+// IsRevoked compares at the one-second precision of iat, so a token issued in
+// the same second as a revocation is treated as revoked.
+func (d *TokenDenylist) IsRevoked(ctx context.Context, claim *application.TokenClaim) (bool, error) {
+	if err := d.sync(ctx); err != nil {
+		return false, err
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	keys := []string{userKey(claim.UserID)}
+	if claim.SessionID != uuid.Nil {
+		keys = append(keys, sessionKey(claim.SessionID))
+	}
+
+	for _, key := range keys {
+		revokedAt, ok := d.entries[key]
+		if ok && !claim.IssuedAt.After(revokedAt) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (d *TokenDenylist) revoke(ctx context.Context, key string) error {
+	now := time.Now().UTC()
+	entry := &application.RevokedAccess{
+		Key:       key,
+		RevokedAt: now,
+		ExpiresAt: now.Add(d.ttl),
+	}
+
+	if err := d.repo.Save(ctx, entry); err != nil {
+		return fmt.Errorf("save revoked access: %w", err)
+	}
+
+	d.mu.Lock()
+	d.entries[key] = now
+	d.mu.Unlock()
+
+	// Revocations are rare, so they are a cheap moment to drop stale rows.
+	return d.repo.DeleteExpired(ctx, now)
+}
+
+func (d *TokenDenylist) sync(ctx context.Context) error {
+	d.syncMu.Lock()
+	defer d.syncMu.Unlock()
+
+	now := time.Now().UTC()
+	if now.Sub(d.syncedAt) < d.syncInterval {
+		return nil
+	}
+
+	active, err := d.repo.FindActive(ctx, now)
+	if err != nil {
+		return fmt.Errorf("load revoked access: %w", err)
+	}
+
+	entries := make(map[string]time.Time, len(active))
+	for _, entry := range active {
+		entries[entry.Key] = entry.RevokedAt
+	}
+
+	d.mu.Lock()
+	// Keep revocations made on this instance while the snapshot was loading.
+	for key, revokedAt := range d.entries {
+		if !revokedAt.Before(now) {
+			entries[key] = revokedAt
+		}
+	}
+	d.entries = entries
+	d.syncedAt = now
+	d.mu.Unlock()
+
+	return nil
+}
+
+func sessionKey(id uuid.UUID) string {
+	return "sid:" + id.String()
+}
+
+func userKey(id uuid.UUID) string {
+	return "sub:" + id.String()
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/middleware"
+	"trainer/internal/interfaces/http/response"
+)
+
+// maxAvatarBytes caps the avatar upload, multipart framing included.
+const maxAvatarBytes = 2 << 20
+
+var errMissingAvatar = errors.New("multipart form has no avatar field")
+
+// AccountHandler serves /me, the account of the authenticated caller.
+type AccountHandler struct {
+	getUserUC        *usecase.GetUser
+	updateProfileUC  *usecase.UpdateProfile
+	changePasswordUC *usecase.ChangePassword
+	changeEmailUC    *usecase.ChangeEmail
+	deleteAccountUC  *usecase.DeleteAccount
+	uploadAvatarUC   *usecase.UploadAvatar
+	deleteAvatarUC   *usecase.DeleteAvatar
+}
+
+func NewAccountHandler(
+	getUserUC *usecase.GetUser,
+	updateProfileUC *usecase.UpdateProfile,
+	changePasswordUC *usecase.ChangePassword,
+	changeEmailUC *usecase.ChangeEmail,
+	deleteAccountUC *usecase.DeleteAccount,
+	uploadAvatarUC *usecase.UploadAvatar,
+	deleteAvatarUC *usecase.DeleteAvatar,
+) *AccountHandler {
+	return &AccountHandler{
+		getUserUC:        getUserUC,
+		updateProfileUC:  updateProfileUC,
+		changePasswordUC: changePasswordUC,
+		changeEmailUC:    changeEmailUC,
+		deleteAccountUC:  deleteAccountUC,
+		uploadAvatarUC:   uploadAvatarUC,
+		deleteAvatarUC:   deleteAvatarUC,
+	}
+}
+
+func (h *AccountHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	caller := actor(r)
+	req := dto.GetUserRequest{
+		Actor: caller,
+		Id:    caller.ID.String(),
+	}
+
+	userResp, err := h.getUserUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(userResp.Version))
+	if notModified(r, userResp.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *AccountHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	ifMatch, err := parseETags(r.Header.Get("If-Match"))
+	if err != nil {
+		response.Error(w, http.StatusPreconditionFailed, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.IfMatch = ifMatch
+
+	userResp, err := h.updateProfileUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(userResp.Version))
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *AccountHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req dto.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	if claim, ok := middleware.ClaimFromContext(r.Context()); ok {
+		req.SessionID = claim.SessionID
+	}
+
+	if err := h.changePasswordUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AccountHandler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	var req dto.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	userResp, err := h.changeEmailUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(userResp.Version))
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *AccountHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	var req dto.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	if err := h.deleteAccountUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UploadAvatar takes the image either as the "avatar" field of a multipart
+// form or as the raw request body.
+func (h *AccountHandler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxAvatarBytes)
+
+	content, err := avatarContent(r)
+	if err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	// Sniff the type from the first bytes instead of trusting the client.
+	buffered := bufio.NewReaderSize(content, 512)
+	head, _ := buffered.Peek(512)
+
+	req := dto.UploadAvatarRequest{
+		Actor:       actor(r),
+		ContentType: http.DetectContentType(head),
+		Content:     buffered,
+	}
+
+	userResp, err := h.uploadAvatarUC.Execute(r.Context(), req)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		response.Error(w, http.StatusRequestEntityTooLarge, fmt.Errorf("avatar exceeds %d bytes", tooLarge.Limit))
+		return
+	}
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(userResp.Version))
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *AccountHandler) DeleteAvatar(w http.ResponseWriter, r *http.Request) {
+	req := dto.DeleteAvatarRequest{Actor: actor(r)}
+
+	userResp, err := h.deleteAvatarUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(userResp.Version))
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func avatarContent(r *http.Request) (io.Reader, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+
+	form, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, err := form.NextPart()
+		if err == io.EOF {
+			return nil, errMissingAvatar
+		}
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == "avatar" {
+			return part, nil
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"net/http"
+	"trainer/internal/domain/user"
+	"trainer/internal/interfaces/http/middleware"
+)
+
+// actor identifies the caller for use-case authorization and auditing.
+// Unauthenticated requests get an Actor without ID or role, which holds no
+// permissions.
+func actor(r *http.Request) user.Actor {
+	client := user.ClientInfo{UserAgent: userAgent(r), IP: clientIP(r)}
+
+	claim, ok := middleware.ClaimFromContext(r.Context())
+	if !ok {
+		return user.Actor{Client: client}
+	}
+
+	return user.Actor{ID: claim.UserID, Role: claim.Role, Client: client}
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type AnalyticsHandler struct {
+	studentAnalyticsUC       *usecase.GetStudentAnalytics
+	exportStudentAnalyticsUC *usecase.ExportStudentAnalytics
+	cohortAnalyticsUC        *usecase.GetCohortAnalytics
+	exportCohortAnalyticsUC  *usecase.ExportCohortAnalytics
+}
+
+func NewAnalyticsHandler(
+	studentAnalyticsUC *usecase.GetStudentAnalytics,
+	exportStudentAnalyticsUC *usecase.ExportStudentAnalytics,
+	cohortAnalyticsUC *usecase.GetCohortAnalytics,
+	exportCohortAnalyticsUC *usecase.ExportCohortAnalytics,
+) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		studentAnalyticsUC:       studentAnalyticsUC,
+		exportStudentAnalyticsUC: exportStudentAnalyticsUC,
+		cohortAnalyticsUC:        cohortAnalyticsUC,
+		exportCohortAnalyticsUC:  exportCohortAnalyticsUC,
+	}
+}
+
+func (h *AnalyticsHandler) MyAnalytics(w http.ResponseWriter, r *http.Request) {
+	h.studentAnalytics(w, r, actor(r).ID.String())
+}
+
+func (h *AnalyticsHandler) ExportMyAnalytics(w http.ResponseWriter, r *http.Request) {
+	h.exportStudentAnalytics(w, r, actor(r).ID.String())
+}
+
+func (h *AnalyticsHandler) StudentAnalytics(w http.ResponseWriter, r *http.Request) {
+	h.studentAnalytics(w, r, mux.Vars(r)["id"])
+}
+
+func (h *AnalyticsHandler) ExportStudentAnalytics(w http.ResponseWriter, r *http.Request) {
+	h.exportStudentAnalytics(w, r, mux.Vars(r)["id"])
+}
+
+func (h *AnalyticsHandler) CohortAnalytics(w http.ResponseWriter, r *http.Request) {
+	req := dto.CohortAnalyticsRequest{
+		AnalyticsFilter: analyticsFilter(r),
+		Actor:           actor(r),
+		CohortId:        mux.Vars(r)["id"],
+	}
+
+	reportResp, err := h.cohortAnalyticsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, reportResp)
+}
+
+func (h *AnalyticsHandler) ExportCohortAnalytics(w http.ResponseWriter, r *http.Request) {
+	req := dto.CohortAnalyticsRequest{
+		AnalyticsFilter: analyticsFilter(r),
+		Actor:           actor(r),
+		CohortId:        mux.Vars(r)["id"],
+	}
+
+	out := &exportWriter{w: w, format: dto.FormatCSV, name: "cohort_analytics"}
+	if err := h.exportCohortAnalyticsUC.Execute(r.Context(), req, out); err != nil {
+		if !out.started {
+			response.HandleError(w, err)
+			return
+		}
+		// The status is already sent; the client gets a truncated file.
+		log.Printf("export cohort analytics: %v", err)
+	}
+}
+
+func (h *AnalyticsHandler) studentAnalytics(w http.ResponseWriter, r *http.Request, studentId string) {
+	req := dto.StudentAnalyticsRequest{
+		AnalyticsFilter: analyticsFilter(r),
+		Actor:           actor(r),
+		StudentId:       studentId,
+	}
+
+	reportResp, err := h.studentAnalyticsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, reportResp)
+}
+
+func (h *AnalyticsHandler) exportStudentAnalytics(w http.ResponseWriter, r *http.Request, studentId string) {
+	req := dto.StudentAnalyticsRequest{
+		AnalyticsFilter: analyticsFilter(r),
+		Actor:           actor(r),
+		StudentId:       studentId,
+	}
+
+	out := &exportWriter{w: w, format: dto.FormatCSV, name: "student_analytics"}
+	if err := h.exportStudentAnalyticsUC.Execute(r.Context(), req, out); err != nil {
+		if !out.started {
+			response.HandleError(w, err)
+			return
+		}
+		// The status is already sent; the client gets a truncated file.
+		log.Printf("export student analytics: %v", err)
+	}
+}
+
+func analyticsFilter(r *http.Request) dto.AnalyticsFilter {
+	q := r.URL.Query()
+	return dto.AnalyticsFilter{
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		Timezone: q.Get("tz"),
+	}
+}
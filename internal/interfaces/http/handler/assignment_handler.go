@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type AssignmentHandler struct {
+	createAssignmentUC *usecase.CreateAssignment
+	getAssignmentUC    *usecase.GetAssignment
+	listAssignmentsUC  *usecase.ListAssignments
+	updateAssignmentUC *usecase.UpdateAssignment
+	deleteAssignmentUC *usecase.DeleteAssignment
+	myAssignmentsUC    *usecase.ListMyAssignments
+}
+
+func NewAssignmentHandler(
+	createAssignmentUC *usecase.CreateAssignment,
+	getAssignmentUC *usecase.GetAssignment,
+	listAssignmentsUC *usecase.ListAssignments,
+	updateAssignmentUC *usecase.UpdateAssignment,
+	deleteAssignmentUC *usecase.DeleteAssignment,
+	myAssignmentsUC *usecase.ListMyAssignments,
+) *AssignmentHandler {
+	return &AssignmentHandler{
+		createAssignmentUC: createAssignmentUC,
+		getAssignmentUC:    getAssignmentUC,
+		listAssignmentsUC:  listAssignmentsUC,
+		updateAssignmentUC: updateAssignmentUC,
+		deleteAssignmentUC: deleteAssignmentUC,
+		myAssignmentsUC:    myAssignmentsUC,
+	}
+}
+
+func (h *AssignmentHandler) CreateAssignment(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.CohortId = mux.Vars(r)["id"]
+
+	assignmentResp, err := h.createAssignmentUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, assignmentResp)
+}
+
+func (h *AssignmentHandler) ListAssignments(w http.ResponseWriter, r *http.Request) {
+	req := dto.ListAssignmentsRequest{
+		Actor:    actor(r),
+		CohortId: mux.Vars(r)["id"],
+	}
+
+	assignmentsResp, err := h.listAssignmentsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, assignmentsResp)
+}
+
+func (h *AssignmentHandler) GetAssignment(w http.ResponseWriter, r *http.Request) {
+	req := dto.GetAssignmentRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	assignmentResp, err := h.getAssignmentUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, assignmentResp)
+}
+
+func (h *AssignmentHandler) UpdateAssignment(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.Id = mux.Vars(r)["id"]
+
+	assignmentResp, err := h.updateAssignmentUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, assignmentResp)
+}
+
+func (h *AssignmentHandler) DeleteAssignment(w http.ResponseWriter, r *http.Request) {
+	req := dto.DeleteAssignmentRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	if err := h.deleteAssignmentUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AssignmentHandler) ListMyAssignments(w http.ResponseWriter, r *http.Request) {
+	assignmentsResp, err := h.myAssignmentsUC.Execute(r.Context(), dto.MyAssignmentsRequest{Actor: actor(r)})
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, assignmentsResp)
+}
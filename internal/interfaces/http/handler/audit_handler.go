@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+)
+
+type AuditHandler struct {
+	listAuditEventsUC   *usecase.ListAuditEvents
+	exportAuditEventsUC *usecase.ExportAuditEvents
+}
+
+func NewAuditHandler(listAuditEventsUC *usecase.ListAuditEvents, exportAuditEventsUC *usecase.ExportAuditEvents) *AuditHandler {
+	return &AuditHandler{
+		listAuditEventsUC:   listAuditEventsUC,
+		exportAuditEventsUC: exportAuditEventsUC,
+	}
+}
+
+func (h *AuditHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	req := dto.ListAuditEventsRequest{
+		AuditEventFilter: auditEventFilter(r),
+		Actor:            actor(r),
+		Cursor:           r.URL.Query().Get("cursor"),
+	}
+
+	var err error
+	if req.Limit, err = queryInt(r, "limit"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	eventsResp, err := h.listAuditEventsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, eventsResp)
+}
+
+func (h *AuditHandler) ExportAuditEvents(w http.ResponseWriter, r *http.Request) {
+	req := dto.ExportAuditEventsRequest{
+		AuditEventFilter: auditEventFilter(r),
+		Actor:            actor(r),
+	}
+
+	out := &exportWriter{w: w, format: dto.FormatCSV, name: "audit_events"}
+	if err := h.exportAuditEventsUC.Execute(r.Context(), req, out); err != nil {
+		if !out.started {
+			response.HandleError(w, err)
+			return
+		}
+		// The status is already sent; the client gets a truncated file.
+		log.Printf("export audit events: %v", err)
+	}
+}
+
+func auditEventFilter(r *http.Request) dto.AuditEventFilter {
+	q := r.URL.Query()
+	return dto.AuditEventFilter{
+		Action:   q.Get("action"),
+		ActorID:  q.Get("actor_id"),
+		TargetID: q.Get("target_id"),
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+	}
+}
@@ -5,18 +5,28 @@ import (
 	"net/http"
 	"trainer/internal/application/dto"
 	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/middleware"
 	"trainer/internal/interfaces/http/response"
 )
 
 type AuthHandler struct {
 	accessTokenUC  *usecase.AccessToken
 	refreshTokenUC *usecase.RefreshToken
+	logoutUC       *usecase.Logout
+	logoutAllUC    *usecase.LogoutAll
 }
 
-func NewAuthTokenHandler(loginUserUC *usecase.AccessToken, refreshTokenUC *usecase.RefreshToken) *AuthHandler {
+func NewAuthTokenHandler(
+	loginUserUC *usecase.AccessToken,
+	refreshTokenUC *usecase.RefreshToken,
+	logoutUC *usecase.Logout,
+	logoutAllUC *usecase.LogoutAll,
+) *AuthHandler {
 	return &AuthHandler{
 		accessTokenUC:  loginUserUC,
 		refreshTokenUC: refreshTokenUC,
+		logoutUC:       logoutUC,
+		logoutAllUC:    logoutAllUC,
 	}
 }
 
@@ -27,13 +37,21 @@ func (h *AuthHandler) AccessToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokenResp, err := h.accessTokenUC.Execute(r.Context(), req)
+	req.UserAgent = userAgent(r)
+	req.IP = clientIP(r)
+
+	loginResp, err := h.accessTokenUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, tokenResp)
+	if loginResp.MFARequired {
+		response.JSON(w, http.StatusOK, loginResp)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, loginResp)
 }
 
 func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
@@ -43,11 +61,42 @@ func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.UserAgent = userAgent(r)
+	req.IP = clientIP(r)
+
 	tokenResp, err := h.refreshTokenUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
 	response.JSON(w, http.StatusCreated, tokenResp)
 }
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var req dto.LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	err := h.logoutUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *AuthHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+
+	err := h.logoutAllUC.Execute(r.Context(), dto.LogoutAllRequest{UserId: userID.String()})
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
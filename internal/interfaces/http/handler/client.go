@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net"
+	"net/http"
+	"trainer/internal/interfaces/http/middleware"
+)
+
+const maxUserAgentLength = 512
+
+// clientIP prefers the address resolved by the ClientIP middleware, which
+// knows the trusted reverse proxies.
+func clientIP(r *http.Request) string {
+	if ip, ok := middleware.ClientIPFromContext(r.Context()); ok {
+		return ip
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func userAgent(r *http.Request) string {
+	ua := r.UserAgent()
+	if len(ua) > maxUserAgentLength {
+		return ua[:maxUserAgentLength]
+	}
+	return ua
+}
@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type CohortHandler struct {
+	createCohortUC   *usecase.CreateCohort
+	getCohortUC      *usecase.GetCohort
+	listCohortsUC    *usecase.ListCohorts
+	updateCohortUC   *usecase.UpdateCohort
+	deleteCohortUC   *usecase.DeleteCohort
+	rotateJoinCodeUC *usecase.RotateJoinCode
+	inviteMemberUC   *usecase.InviteCohortMember
+	removeMemberUC   *usecase.RemoveCohortMember
+	joinCohortUC     *usecase.JoinCohort
+	leaveCohortUC    *usecase.LeaveCohort
+	myCohortsUC      *usecase.ListMyCohorts
+}
+
+func NewCohortHandler(
+	createCohortUC *usecase.CreateCohort,
+	getCohortUC *usecase.GetCohort,
+	listCohortsUC *usecase.ListCohorts,
+	updateCohortUC *usecase.UpdateCohort,
+	deleteCohortUC *usecase.DeleteCohort,
+	rotateJoinCodeUC *usecase.RotateJoinCode,
+	inviteMemberUC *usecase.InviteCohortMember,
+	removeMemberUC *usecase.RemoveCohortMember,
+	joinCohortUC *usecase.JoinCohort,
+	leaveCohortUC *usecase.LeaveCohort,
+	myCohortsUC *usecase.ListMyCohorts,
+) *CohortHandler {
+	return &CohortHandler{
+		createCohortUC:   createCohortUC,
+		getCohortUC:      getCohortUC,
+		listCohortsUC:    listCohortsUC,
+		updateCohortUC:   updateCohortUC,
+		deleteCohortUC:   deleteCohortUC,
+		rotateJoinCodeUC: rotateJoinCodeUC,
+		inviteMemberUC:   inviteMemberUC,
+		removeMemberUC:   removeMemberUC,
+		joinCohortUC:     joinCohortUC,
+		leaveCohortUC:    leaveCohortUC,
+		myCohortsUC:      myCohortsUC,
+	}
+}
+
+func (h *CohortHandler) CreateCohort(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCohortRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	cohortResp, err := h.createCohortUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, cohortResp)
+}
+
+func (h *CohortHandler) GetCohort(w http.ResponseWriter, r *http.Request) {
+	req := dto.GetCohortRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	cohortResp, err := h.getCohortUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, cohortResp)
+}
+
+func (h *CohortHandler) ListCohorts(w http.ResponseWriter, r *http.Request) {
+	req := dto.ListCohortsRequest{
+		Actor:    actor(r),
+		MentorId: r.URL.Query().Get("mentor_id"),
+	}
+
+	cohortsResp, err := h.listCohortsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, cohortsResp)
+}
+
+func (h *CohortHandler) UpdateCohort(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateCohortRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.Id = mux.Vars(r)["id"]
+
+	cohortResp, err := h.updateCohortUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, cohortResp)
+}
+
+func (h *CohortHandler) DeleteCohort(w http.ResponseWriter, r *http.Request) {
+	req := dto.DeleteCohortRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	if err := h.deleteCohortUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CohortHandler) RotateJoinCode(w http.ResponseWriter, r *http.Request) {
+	req := dto.RotateJoinCodeRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	cohortResp, err := h.rotateJoinCodeUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, cohortResp)
+}
+
+func (h *CohortHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	var req dto.InviteCohortMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.Id = mux.Vars(r)["id"]
+
+	if err := h.inviteMemberUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, struct{}{})
+}
+
+func (h *CohortHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	req := dto.RemoveCohortMemberRequest{
+		Actor:     actor(r),
+		Id:        vars["id"],
+		StudentId: vars["studentId"],
+	}
+
+	if err := h.removeMemberUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CohortHandler) JoinCohort(w http.ResponseWriter, r *http.Request) {
+	var req dto.JoinCohortRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	cohortResp, err := h.joinCohortUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, cohortResp)
+}
+
+func (h *CohortHandler) LeaveCohort(w http.ResponseWriter, r *http.Request) {
+	req := dto.LeaveCohortRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	if err := h.leaveCohortUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CohortHandler) ListMyCohorts(w http.ResponseWriter, r *http.Request) {
+	cohortsResp, err := h.myCohortsUC.Execute(r.Context(), dto.MyCohortsRequest{Actor: actor(r)})
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, cohortsResp)
+}
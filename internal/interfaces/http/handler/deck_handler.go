@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type DeckHandler struct {
+	createDeckUC *usecase.CreateDeck
+	getDeckUC    *usecase.GetDeck
+	listDecksUC  *usecase.ListDecks
+	updateDeckUC *usecase.UpdateDeck
+	deleteDeckUC *usecase.DeleteDeck
+	addTermUC    *usecase.AddTerm
+	updateTermUC *usecase.UpdateTerm
+	deleteTermUC *usecase.DeleteTerm
+}
+
+func NewDeckHandler(
+	createDeckUC *usecase.CreateDeck,
+	getDeckUC *usecase.GetDeck,
+	listDecksUC *usecase.ListDecks,
+	updateDeckUC *usecase.UpdateDeck,
+	deleteDeckUC *usecase.DeleteDeck,
+	addTermUC *usecase.AddTerm,
+	updateTermUC *usecase.UpdateTerm,
+	deleteTermUC *usecase.DeleteTerm,
+) *DeckHandler {
+	return &DeckHandler{
+		createDeckUC: createDeckUC,
+		getDeckUC:    getDeckUC,
+		listDecksUC:  listDecksUC,
+		updateDeckUC: updateDeckUC,
+		deleteDeckUC: deleteDeckUC,
+		addTermUC:    addTermUC,
+		updateTermUC: updateTermUC,
+		deleteTermUC: deleteTermUC,
+	}
+}
+
+func (h *DeckHandler) CreateDeck(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateDeckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	deckResp, err := h.createDeckUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, deckResp)
+}
+
+func (h *DeckHandler) GetDeck(w http.ResponseWriter, r *http.Request) {
+	req := dto.GetDeckRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	deckResp, err := h.getDeckUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, deckResp)
+}
+
+func (h *DeckHandler) ListDecks(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := dto.ListDecksRequest{
+		Actor:   actor(r),
+		OwnerId: q.Get("owner_id"),
+		Search:  q.Get("search"),
+	}
+
+	var err error
+	if req.Page, err = queryInt(r, "page"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+	if req.Limit, err = queryInt(r, "limit"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	decksResp, err := h.listDecksUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, decksResp)
+}
+
+func (h *DeckHandler) UpdateDeck(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateDeckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.Id = mux.Vars(r)["id"]
+
+	deckResp, err := h.updateDeckUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, deckResp)
+}
+
+func (h *DeckHandler) DeleteDeck(w http.ResponseWriter, r *http.Request) {
+	req := dto.DeleteDeckRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	if err := h.deleteDeckUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DeckHandler) AddTerm(w http.ResponseWriter, r *http.Request) {
+	var req dto.AddTermRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+	req.DeckId = mux.Vars(r)["id"]
+
+	termResp, err := h.addTermUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, termResp)
+}
+
+func (h *DeckHandler) UpdateTerm(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateTermRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	vars := mux.Vars(r)
+	req.Actor = actor(r)
+	req.DeckId = vars["id"]
+	req.TermId = vars["termId"]
+
+	termResp, err := h.updateTermUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, termResp)
+}
+
+func (h *DeckHandler) DeleteTerm(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	req := dto.DeleteTermRequest{
+		Actor:  actor(r),
+		DeckId: vars["id"],
+		TermId: vars["termId"],
+	}
+
+	if err := h.deleteTermUC.Execute(r.Context(), req); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
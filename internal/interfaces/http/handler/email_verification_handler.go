@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+)
+
+type EmailVerificationHandler struct {
+	verifyEmailUC        *usecase.VerifyEmail
+	resendVerificationUC *usecase.ResendVerification
+}
+
+func NewEmailVerificationHandler(verifyEmailUC *usecase.VerifyEmail, resendVerificationUC *usecase.ResendVerification) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		verifyEmailUC:        verifyEmailUC,
+		resendVerificationUC: resendVerificationUC,
+	}
+}
+
+func (h *EmailVerificationHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req dto.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	userResp, err := h.verifyEmailUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *EmailVerificationHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var req dto.ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	err := h.resendVerificationUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, struct{}{})
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errInvalidETag = errors.New("invalid entity tag")
+
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// parseETags reads a list of entity tags such as `"3", W/"4"` into versions.
+// An absent header or "*" yields nil, which matches any version.
+func parseETags(header string) ([]int, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	var versions []int
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return nil, errInvalidETag
+		}
+
+		version, err := strconv.Atoi(tag[1 : len(tag)-1])
+		if err != nil {
+			return nil, errInvalidETag
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// notModified reports whether the If-None-Match header already names the
+// current version.
+func notModified(r *http.Request, version int) bool {
+	header := r.Header.Get("If-None-Match")
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	versions, err := parseETags(header)
+	if err != nil {
+		return false
+	}
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+	"trainer/internal/application"
+	"trainer/internal/interfaces/http/response"
+)
+
+type JWKSHandler struct {
+	keySet application.KeySet
+}
+
+func NewJWKSHandler(keySet application.KeySet) *JWKSHandler {
+	return &JWKSHandler{
+		keySet: keySet,
+	}
+}
+
+func (h *JWKSHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	// Short enough that verifiers pick up a new key soon after rotation.
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	response.JSON(w, http.StatusOK, h.keySet.JWKS())
+}
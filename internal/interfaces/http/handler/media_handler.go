@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"trainer/internal/interfaces/http/response"
+)
+
+// NewMediaHandler serves the files of the local storage in dir. Stored files
+// never change under the same key, so they may be cached for good.
+func NewMediaHandler(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			response.NotFound(w, errors.New("file not found"))
+			return
+		}
+
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		files.ServeHTTP(w, r)
+	})
+}
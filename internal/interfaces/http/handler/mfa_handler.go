@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/middleware"
+	"trainer/internal/interfaces/http/response"
+)
+
+type MFAHandler struct {
+	startTOTPUC   *usecase.StartTOTP
+	confirmTOTPUC *usecase.ConfirmTOTP
+	disableTOTPUC *usecase.DisableTOTP
+	completeMFAUC *usecase.CompleteMFA
+}
+
+func NewMFAHandler(
+	startTOTPUC *usecase.StartTOTP,
+	confirmTOTPUC *usecase.ConfirmTOTP,
+	disableTOTPUC *usecase.DisableTOTP,
+	completeMFAUC *usecase.CompleteMFA,
+) *MFAHandler {
+	return &MFAHandler{
+		startTOTPUC:   startTOTPUC,
+		confirmTOTPUC: confirmTOTPUC,
+		disableTOTPUC: disableTOTPUC,
+		completeMFAUC: completeMFAUC,
+	}
+}
+
+func (h *MFAHandler) StartTOTP(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+
+	enrollmentResp, err := h.startTOTPUC.Execute(r.Context(), dto.StartTOTPRequest{UserId: userID.String()})
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, enrollmentResp)
+}
+
+func (h *MFAHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	var req dto.ConfirmTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	req.UserId = userID.String()
+	req.Actor = actor(r)
+
+	codesResp, err := h.confirmTOTPUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, codesResp)
+}
+
+func (h *MFAHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	var req dto.DisableTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	req.UserId = userID.String()
+	req.Actor = actor(r)
+
+	err := h.disableTOTPUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *MFAHandler) CompleteMFA(w http.ResponseWriter, r *http.Request) {
+	var req dto.CompleteMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	tokenResp, err := h.completeMFAUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, tokenResp)
+}
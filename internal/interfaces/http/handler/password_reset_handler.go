@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+)
+
+type PasswordResetHandler struct {
+	requestResetUC *usecase.RequestPasswordReset
+	confirmResetUC *usecase.ConfirmPasswordReset
+}
+
+func NewPasswordResetHandler(requestResetUC *usecase.RequestPasswordReset, confirmResetUC *usecase.ConfirmPasswordReset) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		requestResetUC: requestResetUC,
+		confirmResetUC: confirmResetUC,
+	}
+}
+
+func (h *PasswordResetHandler) RequestReset(w http.ResponseWriter, r *http.Request) {
+	var req dto.RequestPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	err := h.requestResetUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, struct{}{})
+}
+
+func (h *PasswordResetHandler) ConfirmReset(w http.ResponseWriter, r *http.Request) {
+	var req dto.ConfirmPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.UserAgent = userAgent(r)
+	req.IP = clientIP(r)
+
+	err := h.confirmResetUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
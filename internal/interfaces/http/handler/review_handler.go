@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+)
+
+type ReviewHandler struct {
+	listDueReviewsUC *usecase.ListDueReviews
+	submitReviewUC   *usecase.SubmitReview
+}
+
+func NewReviewHandler(listDueReviewsUC *usecase.ListDueReviews, submitReviewUC *usecase.SubmitReview) *ReviewHandler {
+	return &ReviewHandler{
+		listDueReviewsUC: listDueReviewsUC,
+		submitReviewUC:   submitReviewUC,
+	}
+}
+
+func (h *ReviewHandler) ListDueReviews(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit")
+	if err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+	newLimit, err := queryInt(r, "new_limit")
+	if err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req := dto.DueReviewsRequest{
+		Actor:    actor(r),
+		DeckId:   r.URL.Query().Get("deck_id"),
+		Limit:    limit,
+		NewLimit: newLimit,
+	}
+
+	resp, err := h.listDueReviewsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+func (h *ReviewHandler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	var req dto.SubmitReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Actor = actor(r)
+
+	resp, err := h.submitReviewUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
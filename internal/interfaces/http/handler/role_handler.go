@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type RoleHandler struct {
+	changeRoleUC      *usecase.ChangeRole
+	listRoleChangesUC *usecase.ListRoleChanges
+}
+
+func NewRoleHandler(changeRoleUC *usecase.ChangeRole, listRoleChangesUC *usecase.ListRoleChanges) *RoleHandler {
+	return &RoleHandler{
+		changeRoleUC:      changeRoleUC,
+		listRoleChangesUC: listRoleChangesUC,
+	}
+}
+
+func (h *RoleHandler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	var req dto.ChangeRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req.Id = mux.Vars(r)["id"]
+	req.Actor = actor(r)
+
+	roleResp, err := h.changeRoleUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, roleResp)
+}
+
+func (h *RoleHandler) ListRoleChanges(w http.ResponseWriter, r *http.Request) {
+	req := dto.ListRoleChangesRequest{Id: mux.Vars(r)["id"], Actor: actor(r)}
+
+	changesResp, err := h.listRoleChangesUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, changesResp)
+}
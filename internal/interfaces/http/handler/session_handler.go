@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/middleware"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/gorilla/mux"
+)
+
+type SessionHandler struct {
+	listSessionsUC  *usecase.ListSessions
+	revokeSessionUC *usecase.RevokeSession
+}
+
+func NewSessionHandler(listSessionsUC *usecase.ListSessions, revokeSessionUC *usecase.RevokeSession) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUC:  listSessionsUC,
+		revokeSessionUC: revokeSessionUC,
+	}
+}
+
+func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+
+	req := dto.ListSessionsRequest{UserId: userID.String()}
+
+	sessionsResp, err := h.listSessionsUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, sessionsResp)
+}
+
+func (h *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+
+	req := dto.RevokeSessionRequest{
+		UserId:    userID.String(),
+		SessionId: mux.Vars(r)["id"],
+	}
+
+	err := h.revokeSessionUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
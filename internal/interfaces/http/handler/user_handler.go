@@ -11,11 +11,14 @@ import (
 )
 
 type UserHandler struct {
-	createUserUC *usecase.CreateUser
-	updateUserUC *usecase.UpdateUser
-	deleteUserUC *usecase.DeleteUser
-	getUserUC    *usecase.GetUser
-	listUserUC   *usecase.ListUser
+	createUserUC  *usecase.CreateUser
+	updateUserUC  *usecase.UpdateUser
+	deleteUserUC  *usecase.DeleteUser
+	getUserUC     *usecase.GetUser
+	listUserUC    *usecase.ListUser
+	unlockUserUC  *usecase.UnlockUser
+	searchUserUC  *usecase.SearchUsers
+	restoreUserUC *usecase.RestoreUser
 }
 
 func NewUserHandler(
@@ -24,13 +27,19 @@ func NewUserHandler(
 	deleteUserUC *usecase.DeleteUser,
 	getUserUC *usecase.GetUser,
 	listUserUC *usecase.ListUser,
+	unlockUserUC *usecase.UnlockUser,
+	searchUserUC *usecase.SearchUsers,
+	restoreUserUC *usecase.RestoreUser,
 ) *UserHandler {
 	return &UserHandler{
-		createUserUC: createUserUC,
-		updateUserUC: updateUserUC,
-		deleteUserUC: deleteUserUC,
-		getUserUC:    getUserUC,
-		listUserUC:   listUserUC,
+		createUserUC:  createUserUC,
+		updateUserUC:  updateUserUC,
+		deleteUserUC:  deleteUserUC,
+		getUserUC:     getUserUC,
+		listUserUC:    listUserUC,
+		unlockUserUC:  unlockUserUC,
+		searchUserUC:  searchUserUC,
+		restoreUserUC: restoreUserUC,
 	}
 }
 
@@ -41,9 +50,12 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.UserAgent = userAgent(r)
+	req.IP = clientIP(r)
+
 	userResp, err := h.createUserUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
@@ -57,64 +69,148 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, err := parseETags(r.Header.Get("If-Match"))
+	if err != nil {
+		response.Error(w, http.StatusPreconditionFailed, err)
+		return
+	}
+
 	vars := mux.Vars(r)
 	req.Id = vars["id"]
+	req.Actor = actor(r)
+	req.IfMatch = ifMatch
 
 	userResp, err := h.updateUserUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
+	w.Header().Set("ETag", etag(userResp.Version))
 	response.JSON(w, http.StatusCreated, userResp)
 }
 
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	var req dto.DeleteUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	hard, err := queryBool(r, "hard")
+	if err != nil {
 		response.BadRequest(w, err)
 		return
 	}
 
-	vars := mux.Vars(r)
-	req.Id = vars["id"]
+	req := dto.DeleteUserRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+		Hard:  hard,
+	}
 
-	err := h.deleteUserUC.Execute(r.Context(), req)
+	err = h.deleteUserUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, struct{}{})
+	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
-	var req dto.GetUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.BadRequest(w, err)
+func (h *UserHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	req := dto.RestoreUserRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
+
+	userResp, err := h.restoreUserUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
 		return
 	}
 
-	vars := mux.Vars(r)
-	req.Id = vars["id"]
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	req := dto.GetUserRequest{
+		Actor: actor(r),
+		Id:    mux.Vars(r)["id"],
+	}
 
 	userResp, err := h.getUserUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, userResp)
+	w.Header().Set("ETag", etag(userResp.Version))
+	if notModified(r, userResp.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, userResp)
 }
 
 func (h *UserHandler) ListUser(w http.ResponseWriter, r *http.Request) {
-	var req dto.ListUserRequest
+	q := r.URL.Query()
+	req := dto.ListUserRequest{
+		Actor:       actor(r),
+		CohortId:    q.Get("cohort_id"),
+		Cursor:      q.Get("cursor"),
+		Role:        q.Get("role"),
+		Search:      q.Get("search"),
+		CreatedFrom: q.Get("created_from"),
+		CreatedTo:   q.Get("created_to"),
+		Sort:        q.Get("sort"),
+	}
+
+	var err error
+	if req.Page, err = queryInt(r, "page"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+	if req.Limit, err = queryInt(r, "limit"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
 
 	userResp, err := h.listUserUC.Execute(r.Context(), req)
 	if err != nil {
-		response.InternalError(w, err)
+		response.HandleError(w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, userResp)
+	response.JSON(w, http.StatusOK, userResp)
+}
+
+func (h *UserHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	req := dto.UnlockUserRequest{Id: mux.Vars(r)["id"], Actor: actor(r)}
+
+	err := h.unlockUserUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := dto.SearchUsersRequest{
+		Actor: actor(r),
+		Query: q.Get("q"),
+		Role:  q.Get("role"),
+	}
+
+	var err error
+	if req.Limit, err = queryInt(r, "limit"); err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	searchResp, err := h.searchUserUC.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, searchResp)
 }
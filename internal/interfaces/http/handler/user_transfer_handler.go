@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"trainer/internal/application/dto"
+	"trainer/internal/application/usecase"
+	"trainer/internal/interfaces/http/response"
+)
+
+// maxImportBytes caps the size of an uploaded import file.
+const maxImportBytes = 5 << 20
+
+type UserTransferHandler struct {
+	importUsersUC *usecase.ImportUsers
+	exportUsersUC *usecase.ExportUsers
+}
+
+func NewUserTransferHandler(importUsersUC *usecase.ImportUsers, exportUsersUC *usecase.ExportUsers) *UserTransferHandler {
+	return &UserTransferHandler{
+		importUsersUC: importUsersUC,
+		exportUsersUC: exportUsersUC,
+	}
+}
+
+// ImportUsers takes the file as the request body. The format comes from the
+// format query parameter or, failing that, the Content-Type.
+func (h *UserTransferHandler) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	dryRun, err := queryBool(r, "dry_run")
+	if err != nil {
+		response.BadRequest(w, err)
+		return
+	}
+
+	req := dto.ImportUsersRequest{
+		Actor:  actor(r),
+		Format: transferFormat(r),
+		DryRun: dryRun,
+		Data:   http.MaxBytesReader(w, r.Body, maxImportBytes),
+	}
+
+	resp, err := h.importUsersUC.Execute(r.Context(), req)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		response.Error(w, http.StatusRequestEntityTooLarge, fmt.Errorf("import file exceeds %d bytes", tooLarge.Limit))
+		return
+	}
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	switch {
+	case len(resp.Errors) > 0:
+		response.JSON(w, http.StatusUnprocessableEntity, resp)
+	case resp.DryRun:
+		response.JSON(w, http.StatusOK, resp)
+	default:
+		response.JSON(w, http.StatusCreated, resp)
+	}
+}
+
+func (h *UserTransferHandler) ExportUsers(w http.ResponseWriter, r *http.Request) {
+	req := dto.ExportUsersRequest{
+		Actor:  actor(r),
+		Format: r.URL.Query().Get("format"),
+		Role:   r.URL.Query().Get("role"),
+	}
+	if req.Format == "" {
+		req.Format = dto.FormatCSV
+	}
+
+	out := &exportWriter{w: w, format: req.Format, name: "users"}
+	if err := h.exportUsersUC.Execute(r.Context(), req, out); err != nil {
+		if !out.started {
+			response.HandleError(w, err)
+			return
+		}
+		// The status is already sent; the client gets a truncated file.
+		log.Printf("export users: %v", err)
+	}
+}
+
+func transferFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return dto.FormatCSV
+	case "application/json":
+		return dto.FormatJSON
+	default:
+		return ""
+	}
+}
+
+// exportWriter sends the download headers with the first byte of the body,
+// so a use case error before it can still become a JSON error response.
+type exportWriter struct {
+	w      http.ResponseWriter
+	format string
+	// name is the download file name without extension.
+	name    string
+	started bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+
+		contentType := "text/csv; charset=utf-8"
+		if e.format == dto.FormatJSON {
+			contentType = "application/json"
+		}
+		e.w.Header().Set("Content-Type", contentType)
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, e.name, e.format))
+		e.w.WriteHeader(http.StatusOK)
+	}
+
+	return e.w.Write(p)
+}
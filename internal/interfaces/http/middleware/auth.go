@@ -2,35 +2,70 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 	"trainer/internal/application"
+	"trainer/internal/interfaces/http/response"
+
+	"github.com/google/uuid"
+)
+
+type contextKey int
+
+const (
+	claimKey contextKey = iota
+	clientIPKey
 )
 
-func AuthMiddleware(tokenService application.TokenManager) func(http.Handler) http.Handler {
+func AuthMiddleware(tokenService application.TokenManager, denylist application.TokenDenylist) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				http.Error(w, "missing token", http.StatusUnauthorized)
+				response.Unauthorized(w, errors.New("missing token"))
 				return
 			}
 
 			parts := strings.Split(authHeader, " ")
 			if len(parts) != 2 || parts[0] != "Bearer" {
-				http.Error(w, "invalid auth header", http.StatusUnauthorized)
+				response.Unauthorized(w, errors.New("invalid auth header"))
 				return
 			}
 
 			claims, err := tokenService.Parse(parts[1])
 			if err != nil {
-				http.Error(w, "invalid token", http.StatusUnauthorized)
+				response.Unauthorized(w, errors.New("invalid token"))
+				return
+			}
+
+			revoked, err := denylist.IsRevoked(r.Context(), claims)
+			if err != nil {
+				response.HandleError(w, err)
 				return
 			}
 
-			ctx := context.WithValue(r.Context(), "user_id", claims.UserID)
-			ctx = context.WithValue(ctx, "role", claims.Role)
+			if revoked {
+				response.Unauthorized(w, errors.New("token revoked"))
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimKey, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// ClaimFromContext returns the access token claim set by AuthMiddleware.
+func ClaimFromContext(ctx context.Context) (*application.TokenClaim, bool) {
+	claim, ok := ctx.Value(claimKey).(*application.TokenClaim)
+	return claim, ok
+}
+
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+	return claim.UserID, true
+}
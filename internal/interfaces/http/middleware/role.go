@@ -1,24 +1,52 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
-	"trainer/internal/application"
+	"slices"
 	"trainer/internal/domain/user"
+	"trainer/internal/interfaces/http/response"
 )
 
+// RoleMiddleware and PermissionMiddleware must run after AuthMiddleware;
+// without a claim in the context the request is rejected.
 func RoleMiddleware(allowedRoles ...user.Role) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			claims := r.Context().Value("user").(application.TokenClaim)
+			claims, ok := ClaimFromContext(r.Context())
+			if !ok {
+				response.Unauthorized(w, errors.New("missing token"))
+				return
+			}
+
+			if !slices.Contains(allowedRoles, claims.Role) {
+				response.Forbidden(w, errors.New("forbidden"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PermissionMiddleware requires every given permission.
+func PermissionMiddleware(permissions ...user.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimFromContext(r.Context())
+			if !ok {
+				response.Unauthorized(w, errors.New("missing token"))
+				return
+			}
 
-			for _, role := range allowedRoles {
-				if claims.Role == role {
-					next.ServeHTTP(w, r)
+			for _, permission := range permissions {
+				if !claims.Role.Can(permission) {
+					response.Forbidden(w, errors.New("forbidden"))
 					return
 				}
 			}
 
-			http.Error(w, "forbidden", http.StatusForbidden)
+			next.ServeHTTP(w, r)
 		})
 	}
 }
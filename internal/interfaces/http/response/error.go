@@ -1,19 +1,169 @@
 package response
 
-import "net/http"
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"trainer/internal/application"
+	"trainer/internal/domain/analytics"
+	"trainer/internal/domain/assignment"
+	"trainer/internal/domain/audit"
+	"trainer/internal/domain/cohort"
+	"trainer/internal/domain/deck"
+	"trainer/internal/domain/review"
+	"trainer/internal/domain/user"
+
+	"github.com/go-playground/validator/v10"
+)
 
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error   string       `json:"error"`
+	Code    string       `json:"code"`
+	Details []FieldError `json:"details,omitempty"`
+}
+
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+	Param string `json:"param,omitempty"`
+}
+
+// domainErrors maps sentinel errors to HTTP statuses. The sentinel message
+// doubles as the machine-readable code returned to the client.
+var domainErrors = []struct {
+	err    error
+	status int
+}{
+	{application.ErrInvalidImport, http.StatusBadRequest},
+	{application.ErrImportTooLarge, http.StatusRequestEntityTooLarge},
+	{user.ErrUserNotFound, http.StatusNotFound},
+	{user.ErrEmailAlreadyUsed, http.StatusConflict},
+	{user.ErrInvalidPassword, http.StatusUnauthorized},
+	{user.ErrInvalidCredentials, http.StatusUnauthorized},
+	{user.ErrIncorrectPassword, http.StatusForbidden},
+	{user.ErrUseAccountEndpoint, http.StatusForbidden},
+	{user.ErrInvalidRefreshToken, http.StatusUnauthorized},
+	{user.ErrTokenRefresh, http.StatusUnauthorized},
+	{user.ErrRefreshTokenReused, http.StatusUnauthorized},
+	{user.ErrSessionNotFound, http.StatusNotFound},
+	{user.ErrInvalidResetToken, http.StatusBadRequest},
+	{user.ErrInvalidCursor, http.StatusBadRequest},
+	{audit.ErrInvalidCursor, http.StatusBadRequest},
+	{user.ErrInvalidVerification, http.StatusBadRequest},
+	{user.ErrEmailVerified, http.StatusConflict},
+	{user.ErrEmailNotVerified, http.StatusForbidden},
+	{user.ErrAccountLocked, http.StatusLocked},
+	{user.ErrTooManyAttempts, http.StatusTooManyRequests},
+	{user.ErrMFAAlreadyEnabled, http.StatusConflict},
+	{user.ErrMFANotEnabled, http.StatusConflict},
+	{user.ErrMFARequired, http.StatusForbidden},
+	{user.ErrInvalidMFACode, http.StatusUnauthorized},
+	{user.ErrInvalidMFAChallenge, http.StatusUnauthorized},
+	{user.ErrAccessDenied, http.StatusForbidden},
+	{user.ErrSelfPromotion, http.StatusForbidden},
+	{user.ErrLastAdmin, http.StatusConflict},
+	{user.ErrUserNotDeleted, http.StatusConflict},
+	{user.ErrVersionConflict, http.StatusConflict},
+	{user.ErrPreconditionFailed, http.StatusPreconditionFailed},
+	{user.ErrRestoreExpired, http.StatusGone},
+	{user.ErrAvatarNotFound, http.StatusNotFound},
+	{user.ErrInvalidRole, http.StatusUnprocessableEntity},
+	{user.ErrInvalidDisplayName, http.StatusUnprocessableEntity},
+	{user.ErrInvalidLocale, http.StatusUnprocessableEntity},
+	{user.ErrInvalidTimezone, http.StatusUnprocessableEntity},
+	{user.ErrBioTooLong, http.StatusUnprocessableEntity},
+	{user.ErrInvalidAvatar, http.StatusUnsupportedMediaType},
+	{user.ErrInvalidEmail, http.StatusUnprocessableEntity},
+	{user.ErrEmptyPassword, http.StatusUnprocessableEntity},
+	{user.ErrEmptyEmail, http.StatusUnprocessableEntity},
+	{user.ErrFailedUpdate, http.StatusUnprocessableEntity},
+	{deck.ErrDeckNotFound, http.StatusNotFound},
+	{deck.ErrTermNotFound, http.StatusNotFound},
+	{deck.ErrInvalidTitle, http.StatusUnprocessableEntity},
+	{deck.ErrInvalidDescription, http.StatusUnprocessableEntity},
+	{deck.ErrInvalidTerm, http.StatusUnprocessableEntity},
+	{deck.ErrInvalidTag, http.StatusUnprocessableEntity},
+	{deck.ErrInvalidMedia, http.StatusUnprocessableEntity},
+	{deck.ErrTooManyTerms, http.StatusUnprocessableEntity},
+	{review.ErrInvalidGrade, http.StatusUnprocessableEntity},
+	{cohort.ErrCohortNotFound, http.StatusNotFound},
+	{cohort.ErrInvalidName, http.StatusUnprocessableEntity},
+	{cohort.ErrInvalidDescription, http.StatusUnprocessableEntity},
+	{cohort.ErrInvalidJoinCode, http.StatusUnprocessableEntity},
+	{cohort.ErrJoinCodeTaken, http.StatusConflict},
+	{cohort.ErrNotStudent, http.StatusUnprocessableEntity},
+	{cohort.ErrAlreadyMember, http.StatusConflict},
+	{cohort.ErrNotMember, http.StatusNotFound},
+	{assignment.ErrAssignmentNotFound, http.StatusNotFound},
+	{assignment.ErrInvalidTitle, http.StatusUnprocessableEntity},
+	{assignment.ErrInvalidDueDate, http.StatusUnprocessableEntity},
+	{assignment.ErrInvalidTarget, http.StatusUnprocessableEntity},
+	{analytics.ErrInvalidRange, http.StatusUnprocessableEntity},
+	{analytics.ErrInvalidTimezone, http.StatusUnprocessableEntity},
+}
+
+// HandleError translates an error returned by a use case into a JSON error
+// response. Unknown errors are logged and reported as 500 without details.
+func HandleError(w http.ResponseWriter, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		details := make([]FieldError, len(validationErrs))
+		for i, fe := range validationErrs {
+			details[i] = FieldError{
+				Field: fe.Field(),
+				Rule:  fe.Tag(),
+				Param: fe.Param(),
+			}
+		}
+
+		JSON(w, http.StatusUnprocessableEntity, ErrorResponse{
+			Error:   "validation failed",
+			Code:    application.CodeValidationFailed,
+			Details: details,
+		})
+		return
+	}
+
+	var retry interface{ RetryAfter() time.Duration }
+	if errors.As(err, &retry) {
+		seconds := int(math.Ceil(retry.RetryAfter().Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+	}
+
+	for _, m := range domainErrors {
+		if errors.Is(err, m.err) {
+			JSON(w, m.status, ErrorResponse{Error: err.Error(), Code: m.err.Error()})
+			return
+		}
+	}
+
+	log.Printf("unhandled error: %v", err)
+	JSON(w, http.StatusInternalServerError, ErrorResponse{
+		Error: http.StatusText(http.StatusInternalServerError),
+		Code:  codeForStatus(http.StatusInternalServerError),
+	})
 }
 
 func Error(w http.ResponseWriter, status int, err error) {
-	JSON(w, status, ErrorResponse{Error: err.Error()})
+	JSON(w, status, ErrorResponse{Error: err.Error(), Code: codeForStatus(status)})
 }
 
 func BadRequest(w http.ResponseWriter, err error) {
 	Error(w, http.StatusBadRequest, err)
 }
 
+func Unauthorized(w http.ResponseWriter, err error) {
+	Error(w, http.StatusUnauthorized, err)
+}
+
+func Forbidden(w http.ResponseWriter, err error) {
+	Error(w, http.StatusForbidden, err)
+}
+
 func NotFound(w http.ResponseWriter, err error) {
 	Error(w, http.StatusNotFound, err)
 }
@@ -21,3 +171,7 @@ func NotFound(w http.ResponseWriter, err error) {
 func InternalError(w http.ResponseWriter, err error) {
 	Error(w, http.StatusInternalServerError, err)
 }
+
+func codeForStatus(status int) string {
+	return strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+}
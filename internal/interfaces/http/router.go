@@ -19,12 +19,30 @@ func NewRouter(
 	adminMiddleware mux.MiddlewareFunc,
 	mentorMiddleware mux.MiddlewareFunc,
 	corsMiddleware mux.MiddlewareFunc,
+	clientIPMiddleware mux.MiddlewareFunc,
 	userHandler *handler.UserHandler,
 	loginHandler *handler.AuthHandler,
+	sessionHandler *handler.SessionHandler,
+	passwordResetHandler *handler.PasswordResetHandler,
+	verificationHandler *handler.EmailVerificationHandler,
+	mfaHandler *handler.MFAHandler,
+	jwksHandler *handler.JWKSHandler,
+	roleHandler *handler.RoleHandler,
+	userTransferHandler *handler.UserTransferHandler,
+	auditHandler *handler.AuditHandler,
+	accountHandler *handler.AccountHandler,
+	deckHandler *handler.DeckHandler,
+	reviewHandler *handler.ReviewHandler,
+	cohortHandler *handler.CohortHandler,
+	assignmentHandler *handler.AssignmentHandler,
+	analyticsHandler *handler.AnalyticsHandler,
+	mediaPath string,
+	mediaHandler http.Handler,
 ) http.Handler {
 	r := mux.NewRouter()
 
 	r.Use(corsMiddleware)
+	r.Use(clientIPMiddleware)
 	r.Methods(http.MethodOptions).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
@@ -43,19 +61,101 @@ func NewRouter(
 		http.Redirect(w, r, "/swagger", http.StatusMovedPermanently)
 	}).Methods("GET")
 
+	// Uploads are served here unless a proxy or CDN serves the storage.
+	if mediaPath != "" {
+		r.PathPrefix(mediaPath+"/").Handler(http.StripPrefix(mediaPath, mediaHandler)).Methods("GET", "HEAD")
+	}
+
+	r.HandleFunc("/.well-known/jwks.json", jwksHandler.JWKS).Methods("GET")
+
 	r.HandleFunc("/auth/access_token", loginHandler.AccessToken).Methods("POST")
 	r.HandleFunc("/auth/refresh_token", loginHandler.RefreshToken).Methods("POST")
+	r.HandleFunc("/auth/mfa", mfaHandler.CompleteMFA).Methods("POST")
+	r.HandleFunc("/auth/logout", loginHandler.Logout).Methods("POST")
+	r.HandleFunc("/auth/password_reset", passwordResetHandler.RequestReset).Methods("POST")
+	r.HandleFunc("/auth/password_reset/confirm", passwordResetHandler.ConfirmReset).Methods("POST")
+	r.HandleFunc("/auth/verify_email", verificationHandler.VerifyEmail).Methods("POST")
+	r.HandleFunc("/auth/verify_email/resend", verificationHandler.ResendVerification).Methods("POST")
 	r.HandleFunc("/users", userHandler.CreateUser).Methods("PUT")
 
 	api := r.NewRoute().Subrouter()
 	api.Use(authMiddleware)
 
+	api.HandleFunc("/auth/logout_all", loginHandler.LogoutAll).Methods("POST")
+	api.HandleFunc("/me", accountHandler.GetMe).Methods("GET")
+	api.HandleFunc("/me", accountHandler.UpdateMe).Methods("PATCH")
+	api.HandleFunc("/me", accountHandler.DeleteMe).Methods("DELETE")
+	api.HandleFunc("/me/password", accountHandler.ChangePassword).Methods("POST")
+	api.HandleFunc("/me/email", accountHandler.ChangeEmail).Methods("POST")
+	api.HandleFunc("/me/avatar", accountHandler.UploadAvatar).Methods("PUT")
+	api.HandleFunc("/me/avatar", accountHandler.DeleteAvatar).Methods("DELETE")
+	api.HandleFunc("/me/analytics", analyticsHandler.MyAnalytics).Methods("GET")
+	api.HandleFunc("/me/analytics/export", analyticsHandler.ExportMyAnalytics).Methods("GET")
+	api.HandleFunc("/me/assignments", assignmentHandler.ListMyAssignments).Methods("GET")
+	api.HandleFunc("/me/cohorts", cohortHandler.ListMyCohorts).Methods("GET")
+	api.HandleFunc("/me/cohorts/join", cohortHandler.JoinCohort).Methods("POST")
+	api.HandleFunc("/me/cohorts/{id}", cohortHandler.LeaveCohort).Methods("DELETE")
+	api.HandleFunc("/me/reviews/due", reviewHandler.ListDueReviews).Methods("GET")
+	api.HandleFunc("/me/reviews", reviewHandler.SubmitReview).Methods("POST")
+	api.HandleFunc("/me/sessions", sessionHandler.ListSessions).Methods("GET")
+	api.HandleFunc("/me/sessions/{id}", sessionHandler.RevokeSession).Methods("DELETE")
+	api.HandleFunc("/me/mfa/totp", mfaHandler.StartTOTP).Methods("POST")
+	api.HandleFunc("/me/mfa/totp/confirm", mfaHandler.ConfirmTOTP).Methods("POST")
+	api.HandleFunc("/me/mfa/totp", mfaHandler.DisableTOTP).Methods("DELETE")
+
+	// Registered before /users/{id} so "search", "import" and "export" are
+	// not taken for an id.
+	mentorRoutes := api.NewRoute().Subrouter()
+	mentorRoutes.Use(mentorMiddleware)
+	mentorRoutes.HandleFunc("/users", userHandler.ListUser).Methods("GET")
+	mentorRoutes.HandleFunc("/users/search", userHandler.SearchUsers).Methods("GET")
+	mentorRoutes.HandleFunc("/users/{id}/analytics", analyticsHandler.StudentAnalytics).Methods("GET")
+	mentorRoutes.HandleFunc("/users/{id}/analytics/export", analyticsHandler.ExportStudentAnalytics).Methods("GET")
+
+	// Mentors own their decks; the use cases let admins manage any deck.
+	mentorRoutes.HandleFunc("/decks", deckHandler.ListDecks).Methods("GET")
+	mentorRoutes.HandleFunc("/decks", deckHandler.CreateDeck).Methods("POST")
+	mentorRoutes.HandleFunc("/decks/{id}", deckHandler.GetDeck).Methods("GET")
+	mentorRoutes.HandleFunc("/decks/{id}", deckHandler.UpdateDeck).Methods("POST")
+	mentorRoutes.HandleFunc("/decks/{id}", deckHandler.DeleteDeck).Methods("DELETE")
+	mentorRoutes.HandleFunc("/decks/{id}/terms", deckHandler.AddTerm).Methods("POST")
+	mentorRoutes.HandleFunc("/decks/{id}/terms/{termId}", deckHandler.UpdateTerm).Methods("POST")
+	mentorRoutes.HandleFunc("/decks/{id}/terms/{termId}", deckHandler.DeleteTerm).Methods("DELETE")
+
+	// Mentors lead their own cohorts and only see the students in them.
+	mentorRoutes.HandleFunc("/cohorts", cohortHandler.ListCohorts).Methods("GET")
+	mentorRoutes.HandleFunc("/cohorts", cohortHandler.CreateCohort).Methods("POST")
+	mentorRoutes.HandleFunc("/cohorts/{id}", cohortHandler.GetCohort).Methods("GET")
+	mentorRoutes.HandleFunc("/cohorts/{id}", cohortHandler.UpdateCohort).Methods("POST")
+	mentorRoutes.HandleFunc("/cohorts/{id}", cohortHandler.DeleteCohort).Methods("DELETE")
+	mentorRoutes.HandleFunc("/cohorts/{id}/join_code", cohortHandler.RotateJoinCode).Methods("POST")
+	mentorRoutes.HandleFunc("/cohorts/{id}/invitations", cohortHandler.InviteMember).Methods("POST")
+	mentorRoutes.HandleFunc("/cohorts/{id}/members/{studentId}", cohortHandler.RemoveMember).Methods("DELETE")
+	mentorRoutes.HandleFunc("/cohorts/{id}/analytics", analyticsHandler.CohortAnalytics).Methods("GET")
+	mentorRoutes.HandleFunc("/cohorts/{id}/analytics/export", analyticsHandler.ExportCohortAnalytics).Methods("GET")
+	mentorRoutes.HandleFunc("/cohorts/{id}/assignments", assignmentHandler.ListAssignments).Methods("GET")
+	mentorRoutes.HandleFunc("/cohorts/{id}/assignments", assignmentHandler.CreateAssignment).Methods("POST")
+	mentorRoutes.HandleFunc("/assignments/{id}", assignmentHandler.GetAssignment).Methods("GET")
+	mentorRoutes.HandleFunc("/assignments/{id}", assignmentHandler.UpdateAssignment).Methods("POST")
+	mentorRoutes.HandleFunc("/assignments/{id}", assignmentHandler.DeleteAssignment).Methods("DELETE")
+
 	adminRoutes := api.NewRoute().Subrouter()
-	//adminRoutes.Use(adminMiddleware)
-	adminRoutes.HandleFunc("/users", userHandler.ListUser).Methods("GET")
-	adminRoutes.HandleFunc("/users/{id}", userHandler.UpdateUser).Methods("POST")
-	adminRoutes.HandleFunc("/users/{id}", userHandler.GetUser).Methods("GET")
+	adminRoutes.Use(adminMiddleware)
+	adminRoutes.HandleFunc("/users/import", userTransferHandler.ImportUsers).Methods("POST")
+	adminRoutes.HandleFunc("/users/export", userTransferHandler.ExportUsers).Methods("GET")
+
+	// Users may read and edit their own account; the use cases require
+	// users:read or users:write for anyone else's.
+	api.HandleFunc("/users/{id}", userHandler.UpdateUser).Methods("POST")
+	api.HandleFunc("/users/{id}", userHandler.GetUser).Methods("GET")
+
 	adminRoutes.HandleFunc("/users/{id}", userHandler.DeleteUser).Methods("DELETE")
+	adminRoutes.HandleFunc("/users/{id}/unlock", userHandler.UnlockUser).Methods("POST")
+	adminRoutes.HandleFunc("/users/{id}/restore", userHandler.RestoreUser).Methods("POST")
+	adminRoutes.HandleFunc("/users/{id}/role", roleHandler.ChangeRole).Methods("POST")
+	adminRoutes.HandleFunc("/users/{id}/role_changes", roleHandler.ListRoleChanges).Methods("GET")
+	adminRoutes.HandleFunc("/audit_events", auditHandler.ListAuditEvents).Methods("GET")
+	adminRoutes.HandleFunc("/audit_events/export", auditHandler.ExportAuditEvents).Methods("GET")
 
 	return r
 }
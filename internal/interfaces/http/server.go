@@ -7,9 +7,10 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
-	"time"
 	"trainer/internal/app"
+	"trainer/internal/config"
 	"trainer/internal/domain/user"
 	"trainer/internal/interfaces/http/middleware"
 
@@ -18,53 +19,116 @@ import (
 )
 
 type Server struct {
-	db             *database.DB
-	allowedDomains string
-	httpServer     *http.Server
+	db         *database.DB
+	cfg        *config.Config
+	httpServer *http.Server
 }
 
-func NewServer(db *database.DB, allowedDomains string) *Server {
+func NewServer(db *database.DB, cfg *config.Config) *Server {
 	return &Server{
-		db:             db,
-		allowedDomains: allowedDomains,
+		db:  db,
+		cfg: cfg,
 	}
 }
 
 func (s *Server) Run(ctx context.Context) error {
-	c, err := app.NewContainer(s.db)
+	c, err := app.NewContainer(s.db, s.cfg)
 	if err != nil {
 		return err
 	}
 
-	tokenHandler := handler.NewAuthTokenHandler(c.AccessTokenUC, c.RefreshTokenUC)
-	userHandler := handler.NewUserHandler(c.CreateUserUC, c.UpdateUserUC, c.DeleteUserUC, c.GetUserUC, c.ListUserUC)
+	tokenHandler := handler.NewAuthTokenHandler(c.AccessTokenUC, c.RefreshTokenUC, c.LogoutUC, c.LogoutAllUC)
+	userHandler := handler.NewUserHandler(c.CreateUserUC, c.UpdateUserUC, c.DeleteUserUC, c.GetUserUC, c.ListUserUC, c.UnlockUserUC, c.SearchUsersUC, c.RestoreUserUC)
+	sessionHandler := handler.NewSessionHandler(c.ListSessionsUC, c.RevokeSessionUC)
+	passwordResetHandler := handler.NewPasswordResetHandler(c.RequestResetUC, c.ConfirmResetUC)
+	verificationHandler := handler.NewEmailVerificationHandler(c.VerifyEmailUC, c.ResendVerifyUC)
+	mfaHandler := handler.NewMFAHandler(c.StartTOTPUC, c.ConfirmTOTPUC, c.DisableTOTPUC, c.CompleteMFAUC)
+	jwksHandler := handler.NewJWKSHandler(c.KeySet)
+	roleHandler := handler.NewRoleHandler(c.ChangeRoleUC, c.RoleChangesUC)
+	userTransferHandler := handler.NewUserTransferHandler(c.ImportUsersUC, c.ExportUsersUC)
+	auditHandler := handler.NewAuditHandler(c.AuditEventsUC, c.ExportAuditUC)
+	accountHandler := handler.NewAccountHandler(
+		c.GetUserUC, c.UpdateProfileUC, c.ChangePassUC, c.ChangeEmailUC, c.DeleteAccountUC, c.UploadAvatarUC, c.DeleteAvatarUC,
+	)
+
+	deckHandler := handler.NewDeckHandler(
+		c.CreateDeckUC, c.GetDeckUC, c.ListDecksUC, c.UpdateDeckUC, c.DeleteDeckUC, c.AddTermUC, c.UpdateTermUC, c.DeleteTermUC,
+	)
+
+	reviewHandler := handler.NewReviewHandler(c.DueReviewsUC, c.SubmitReviewUC)
+	cohortHandler := handler.NewCohortHandler(
+		c.CreateCohortUC, c.GetCohortUC, c.ListCohortsUC, c.UpdateCohortUC, c.DeleteCohortUC, c.RotateCodeUC,
+		c.InviteMemberUC, c.RemoveMemberUC, c.JoinCohortUC, c.LeaveCohortUC, c.MyCohortsUC,
+	)
+	assignmentHandler := handler.NewAssignmentHandler(
+		c.CreateAssignUC, c.GetAssignUC, c.ListAssignsUC, c.UpdateAssignUC, c.DeleteAssignUC, c.MyAssignsUC,
+	)
+	analyticsHandler := handler.NewAnalyticsHandler(c.StudentStatsUC, c.ExportStudentUC, c.CohortStatsUC, c.ExportCohortUC)
+
+	var mediaPath string
+	if strings.HasPrefix(s.cfg.Storage.URL, "/") {
+		mediaPath = s.cfg.Storage.URL
+	}
 
-	authMiddleware := middleware.AuthMiddleware(c.TokenManager)
-	adminMiddleware := middleware.RoleMiddleware(user.RoleAdmin)
-	mentorMiddleware := middleware.RoleMiddleware(user.RoleAdmin, user.RoleMentor)
+	authMiddleware := middleware.AuthMiddleware(c.TokenManager, c.Denylist)
+	adminMiddleware := middleware.PermissionMiddleware(user.PermissionUsersWrite)
+	mentorMiddleware := middleware.PermissionMiddleware(user.PermissionUsersRead)
 
 	router := NewRouter(
 		authMiddleware,
 		adminMiddleware,
 		mentorMiddleware,
-		middleware.CORS(s.allowedDomains),
+		middleware.CORS(s.cfg.CORS.AllowedOrigins),
+		middleware.ClientIP(s.cfg.HTTP.TrustedProxies),
 		userHandler,
 		tokenHandler,
+		sessionHandler,
+		passwordResetHandler,
+		verificationHandler,
+		mfaHandler,
+		jwksHandler,
+		roleHandler,
+		userTransferHandler,
+		auditHandler,
+		accountHandler,
+		deckHandler,
+		reviewHandler,
+		cohortHandler,
+		assignmentHandler,
+		analyticsHandler,
+		mediaPath,
+		handler.NewMediaHandler(s.cfg.Storage.Dir),
 	)
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
-	}
+	port := s.cfg.HTTP.Port
 
 	s.httpServer = &http.Server{
 		Addr:         fmt.Sprintf(":%s", port),
 		Handler:      router,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		ReadTimeout:  s.cfg.HTTP.ReadTimeout,
+		WriteTimeout: s.cfg.HTTP.WriteTimeout,
+		IdleTimeout:  s.cfg.HTTP.IdleTimeout,
 	}
 
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	go app.RunPeriodically(jobsCtx, "purge deleted users", s.cfg.Deletion.PurgeInterval, func(ctx context.Context) error {
+		purged, err := c.PurgeUsersUC.Execute(ctx)
+		if purged > 0 {
+			log.Printf("Purged %d deleted users", purged)
+		}
+		return err
+	})
+
+	go app.RunPeriodically(jobsCtx, "purge expired refresh tokens", s.cfg.Deletion.PurgeInterval, func(ctx context.Context) error {
+		purged, err := c.PurgeTokensUC.Execute(ctx)
+		if purged > 0 {
+			log.Printf("Purged %d expired refresh tokens", purged)
+		}
+		return err
+	})
+
 	serverErrors := make(chan error, 1)
 
 	go func() {
@@ -82,7 +146,7 @@ func (s *Server) Run(ctx context.Context) error {
 	case sig := <-shutdown:
 		log.Printf("Received signal %v, starting graceful shutdown", sig)
 
-		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		ctx, cancel := context.WithTimeout(ctx, s.cfg.HTTP.ShutdownTimeout)
 		defer cancel()
 
 		if err := s.httpServer.Shutdown(ctx); err != nil {